@@ -17,6 +17,7 @@ package internal // import "go.opentelemetry.io/otel/bridge/opencensus/internal/
 import (
 	"errors"
 	"fmt"
+	"sort"
 
 	ocmetricdata "go.opencensus.io/metric/metricdata"
 
@@ -31,7 +32,10 @@ var (
 	errMismatchedValueTypes         = errors.New("wrong value type for data point")
 	errNumberDataPoint              = errors.New("converting a number data point")
 	errHistogramDataPoint           = errors.New("converting a histogram data point")
+	errSummaryDataPoint             = errors.New("converting a summary data point")
 	errNegativeDistributionCount    = errors.New("distribution count is negative")
+	errNegativeSummaryCount         = errors.New("summary count is negative")
+	errMissingSummaryCountAndSum    = errors.New("summary count and sum are not set")
 	errNegativeBucketCount          = errors.New("distribution bucket count is negative")
 	errMismatchedAttributeKeyValues = errors.New("mismatched number of attribute keys and values")
 )
@@ -77,7 +81,8 @@ func convertAggregation(metric *ocmetricdata.Metric) (metricdata.Aggregation, er
 		return convertSum[float64](labelKeys, metric.TimeSeries)
 	case ocmetricdata.TypeCumulativeDistribution:
 		return convertHistogram(labelKeys, metric.TimeSeries)
-		// TODO: Support summaries, once it is in the OTel data types.
+	case ocmetricdata.TypeSummary:
+		return convertSummary(labelKeys, metric.TimeSeries)
 	}
 	return nil, fmt.Errorf("%w: %q", errAggregationType, metric.Descriptor.Type)
 }
@@ -171,6 +176,66 @@ func convertHistogram(labelKeys []ocmetricdata.LabelKey, ts []*ocmetricdata.Time
 	return metricdata.Histogram{DataPoints: points, Temporality: metricdata.CumulativeTemporality}, aggregatedError
 }
 
+// convertSummary converts OpenCensus Summary timeseries to an OpenTelemetry
+// Summary aggregation.
+func convertSummary(labelKeys []ocmetricdata.LabelKey, ts []*ocmetricdata.TimeSeries) (metricdata.Summary, error) {
+	points := make([]metricdata.SummaryDataPoint, 0, len(ts))
+	var errInfo []string
+	for _, t := range ts {
+		attrs, err := convertAttrs(labelKeys, t.LabelValues)
+		if err != nil {
+			errInfo = append(errInfo, err.Error())
+			continue
+		}
+		for _, p := range t.Points {
+			summary, ok := p.Value.(*ocmetricdata.Summary)
+			if !ok {
+				errInfo = append(errInfo, fmt.Sprintf("%v: %d", errMismatchedValueTypes, p.Value))
+				continue
+			}
+			if !summary.HasCountAndSum {
+				errInfo = append(errInfo, errMissingSummaryCountAndSum.Error())
+				continue
+			}
+			if summary.Count < 0 {
+				errInfo = append(errInfo, fmt.Sprintf("%v: %d", errNegativeSummaryCount, summary.Count))
+				continue
+			}
+			points = append(points, metricdata.SummaryDataPoint{
+				Attributes:     attrs,
+				StartTime:      t.StartTime,
+				Time:           p.Time,
+				Count:          uint64(summary.Count),
+				Sum:            summary.Sum,
+				QuantileValues: convertQuantiles(summary.Snapshot),
+			})
+		}
+	}
+	var aggregatedError error
+	if len(errInfo) > 0 {
+		aggregatedError = fmt.Errorf("%w: %v", errSummaryDataPoint, errInfo)
+	}
+	return metricdata.Summary{DataPoints: points}, aggregatedError
+}
+
+// convertQuantiles converts the OpenCensus Snapshot percentiles to a slice of
+// OpenTelemetry QuantileValues sorted by quantile.
+func convertQuantiles(snapshot ocmetricdata.Snapshot) []metricdata.QuantileValue {
+	quantileValues := make([]metricdata.QuantileValue, 0, len(snapshot.Percentiles))
+	for percentile, value := range snapshot.Percentiles {
+		quantileValues = append(quantileValues, metricdata.QuantileValue{
+			// OpenCensus percentiles are in the range (0, 100], while
+			// OpenTelemetry quantiles are in the range [0.0, 1.0].
+			Quantile: percentile / 100.0,
+			Value:    value,
+		})
+	}
+	sort.Slice(quantileValues, func(i, j int) bool {
+		return quantileValues[i].Quantile < quantileValues[j].Quantile
+	})
+	return quantileValues
+}
+
 // convertBucketCounts converts from OpenCensus bucket counts to slice of uint64.
 func convertBucketCounts(buckets []ocmetricdata.Bucket) ([]uint64, error) {
 	bucketCounts := make([]uint64, len(buckets))
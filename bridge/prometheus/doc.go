@@ -0,0 +1,32 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package prometheus provides a bridge from Prometheus to OpenTelemetry.
+//
+// The Prometheus Bridge allows using the Prometheus Golang client library
+// (github.com/prometheus/client_golang) with the OpenTelemetry SDK. This
+// enables prometheus instrumentation libraries to be used with
+// OpenTelemetry exporters, including OTLP.
+//
+// Prometheus histograms and counters are translated to cumulative OpenTelemetry
+// histograms and monotonic sums. Prometheus gauges and untyped metrics are
+// translated to OpenTelemetry gauges, and Prometheus summaries are translated
+// to OpenTelemetry summaries. Metrics of any other type are dropped and an
+// error is sent to the OpenTelemetry ErrorHandler.
+//
+// The bridge is a metric Producer that needs to be registered with a metric
+// Reader:
+//
+//	reader := metric.NewManualReader(metric.WithProducer(prometheus.NewMetricProducer()))
+package prometheus // import "go.opentelemetry.io/otel/bridge/prometheus"
@@ -0,0 +1,325 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus // import "go.opentelemetry.io/otel/bridge/prometheus"
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+const (
+	scopeName = "go.opentelemetry.io/otel/bridge/prometheus"
+
+	traceIDLabel = "trace_id"
+	spanIDLabel  = "span_id"
+)
+
+var (
+	errUnsupportedType = errors.New("unsupported metric type")
+
+	// processStartTime is used as the start time of all cumulative data.
+	// Prometheus does not expose when a series was started, this is the best
+	// approximation for the common case of a process-global registry.
+	processStartTime = time.Now()
+)
+
+type producer struct {
+	gatherers []prometheus.Gatherer
+}
+
+// NewMetricProducer returns a metric.Producer that fetches metrics from
+// Prometheus. This can be used to allow Prometheus instrumentation to be
+// added to an OpenTelemetry export pipeline.
+func NewMetricProducer(opts ...Option) metric.Producer {
+	cfg := newConfig(opts...)
+	return &producer{gatherers: cfg.gatherers}
+}
+
+// Produce gathers the metrics from all Gatherers of p and converts them to
+// OpenTelemetry metric data. Errors gathering or converting metrics are sent
+// to the OpenTelemetry ErrorHandler and the metrics that could be converted
+// are returned.
+func (p *producer) Produce(context.Context) ([]metricdata.ScopeMetrics, error) {
+	now := time.Now()
+	var (
+		errs        multierror
+		otelMetrics []metricdata.Metrics
+	)
+	for _, gatherer := range p.gatherers {
+		promMetrics, err := gatherer.Gather()
+		if err != nil {
+			// Gather can return partial results along with an error, convert
+			// what was gathered.
+			errs = append(errs, err)
+		}
+		var m []metricdata.Metrics
+		m, err = convertPrometheusMetrics(promMetrics, now)
+		otelMetrics = append(otelMetrics, m...)
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		otel.Handle(errs)
+	}
+	if len(otelMetrics) == 0 {
+		return nil, nil
+	}
+	return []metricdata.ScopeMetrics{{
+		Scope:   instrumentation.Scope{Name: scopeName},
+		Metrics: otelMetrics,
+	}}, nil
+}
+
+// convertPrometheusMetrics converts Prometheus metric families into
+// OpenTelemetry Metrics. Families that cannot be converted are skipped and an
+// error describing them is returned.
+func convertPrometheusMetrics(promMetrics []*dto.MetricFamily, now time.Time) ([]metricdata.Metrics, error) {
+	var errs multierror
+	otelMetrics := make([]metricdata.Metrics, 0, len(promMetrics))
+	for _, pm := range promMetrics {
+		if len(pm.GetMetric()) == 0 {
+			// This shouldn't ever happen
+			continue
+		}
+		newMetric := metricdata.Metrics{
+			Name:        pm.GetName(),
+			Description: pm.GetHelp(),
+		}
+		switch pm.GetType() {
+		case dto.MetricType_GAUGE, dto.MetricType_UNTYPED:
+			newMetric.Data = convertGauge(pm.GetMetric(), pm.GetType(), now)
+		case dto.MetricType_COUNTER:
+			newMetric.Data = convertCounter(pm.GetMetric(), now)
+		case dto.MetricType_HISTOGRAM:
+			newMetric.Data = convertHistogram(pm.GetMetric(), now)
+		case dto.MetricType_SUMMARY:
+			newMetric.Data = convertSummary(pm.GetMetric(), now)
+		default:
+			errs = append(errs, fmt.Errorf("%w: %v for metric %q", errUnsupportedType, pm.GetType(), pm.GetName()))
+			continue
+		}
+		otelMetrics = append(otelMetrics, newMetric)
+	}
+	if len(errs) > 0 {
+		return otelMetrics, errs
+	}
+	return otelMetrics, nil
+}
+
+func convertGauge(metrics []*dto.Metric, typ dto.MetricType, now time.Time) metricdata.Gauge[float64] {
+	otelGauge := metricdata.Gauge[float64]{
+		DataPoints: make([]metricdata.DataPoint[float64], len(metrics)),
+	}
+	for i, m := range metrics {
+		value := m.GetGauge().GetValue()
+		if typ == dto.MetricType_UNTYPED {
+			value = m.GetUntyped().GetValue()
+		}
+		otelGauge.DataPoints[i] = metricdata.DataPoint[float64]{
+			Attributes: convertLabels(m.GetLabel()),
+			Time:       timestamp(m, now),
+			Value:      value,
+		}
+	}
+	return otelGauge
+}
+
+func convertCounter(metrics []*dto.Metric, now time.Time) metricdata.Sum[float64] {
+	otelCounter := metricdata.Sum[float64]{
+		DataPoints:  make([]metricdata.DataPoint[float64], len(metrics)),
+		Temporality: metricdata.CumulativeTemporality,
+		IsMonotonic: true,
+	}
+	for i, m := range metrics {
+		dp := metricdata.DataPoint[float64]{
+			Attributes: convertLabels(m.GetLabel()),
+			StartTime:  processStartTime,
+			Time:       timestamp(m, now),
+			Value:      m.GetCounter().GetValue(),
+		}
+		if ex := m.GetCounter().GetExemplar(); ex != nil {
+			dp.Exemplars = []metricdata.Exemplar[float64]{convertExemplar(ex)}
+		}
+		otelCounter.DataPoints[i] = dp
+	}
+	return otelCounter
+}
+
+func convertHistogram(metrics []*dto.Metric, now time.Time) metricdata.Histogram {
+	otelHistogram := metricdata.Histogram{
+		DataPoints:  make([]metricdata.HistogramDataPoint, len(metrics)),
+		Temporality: metricdata.CumulativeTemporality,
+	}
+	for i, m := range metrics {
+		h := m.GetHistogram()
+		bounds, bucketCounts, exemplars := convertBuckets(h.GetBucket(), h.GetSampleCount())
+		otelHistogram.DataPoints[i] = metricdata.HistogramDataPoint{
+			Attributes:   convertLabels(m.GetLabel()),
+			StartTime:    processStartTime,
+			Time:         timestamp(m, now),
+			Count:        h.GetSampleCount(),
+			Sum:          h.GetSampleSum(),
+			Bounds:       bounds,
+			BucketCounts: bucketCounts,
+			Exemplars:    exemplars,
+		}
+	}
+	return otelHistogram
+}
+
+func convertSummary(metrics []*dto.Metric, now time.Time) metricdata.Summary {
+	otelSummary := metricdata.Summary{
+		DataPoints: make([]metricdata.SummaryDataPoint, len(metrics)),
+	}
+	for i, m := range metrics {
+		s := m.GetSummary()
+		otelSummary.DataPoints[i] = metricdata.SummaryDataPoint{
+			Attributes:     convertLabels(m.GetLabel()),
+			StartTime:      processStartTime,
+			Time:           timestamp(m, now),
+			Count:          s.GetSampleCount(),
+			Sum:            s.GetSampleSum(),
+			QuantileValues: convertQuantiles(s.GetQuantile()),
+		}
+	}
+	return otelSummary
+}
+
+// convertQuantiles converts Prometheus quantiles into OpenTelemetry
+// QuantileValues.
+func convertQuantiles(quantiles []*dto.Quantile) []metricdata.QuantileValue {
+	otelQuantiles := make([]metricdata.QuantileValue, len(quantiles))
+	for i, q := range quantiles {
+		otelQuantiles[i] = metricdata.QuantileValue{
+			Quantile: q.GetQuantile(),
+			Value:    q.GetValue(),
+		}
+	}
+	return otelQuantiles
+}
+
+// convertBuckets converts the cumulative Prometheus buckets into explicit
+// bounds and non-cumulative bucket counts. The +Inf bucket is implied by
+// OpenTelemetry and its count is determined from count.
+func convertBuckets(buckets []*dto.Bucket, count uint64) ([]float64, []uint64, []metricdata.Exemplar[float64]) {
+	bounds := make([]float64, 0, len(buckets))
+	bucketCounts := make([]uint64, 0, len(buckets)+1)
+	var (
+		exemplars []metricdata.Exemplar[float64]
+		previous  uint64
+	)
+	for _, bucket := range buckets {
+		if ex := bucket.GetExemplar(); ex != nil {
+			exemplars = append(exemplars, convertExemplar(ex))
+		}
+		if math.IsInf(bucket.GetUpperBound(), 1) {
+			// The +Inf bucket is represented by the remaining count.
+			break
+		}
+		bounds = append(bounds, bucket.GetUpperBound())
+		cumulative := bucket.GetCumulativeCount()
+		bucketCounts = append(bucketCounts, cumulative-previous)
+		previous = cumulative
+	}
+	bucketCounts = append(bucketCounts, count-previous)
+	return bounds, bucketCounts, exemplars
+}
+
+// convertLabels converts Prometheus labels into an attribute set.
+func convertLabels(labels []*dto.LabelPair) attribute.Set {
+	kvs := make([]attribute.KeyValue, len(labels))
+	for i, l := range labels {
+		kvs[i] = attribute.String(l.GetName(), l.GetValue())
+	}
+	return attribute.NewSet(kvs...)
+}
+
+// convertExemplar converts a Prometheus exemplar into an OpenTelemetry
+// Exemplar. The "trace_id" and "span_id" labels are decoded as the trace and
+// span ID of the exemplar, all other labels are kept as filtered attributes.
+func convertExemplar(exemplar *dto.Exemplar) metricdata.Exemplar[float64] {
+	var (
+		attrs   []attribute.KeyValue
+		traceID []byte
+		spanID  []byte
+	)
+	for _, label := range exemplar.GetLabel() {
+		var err error
+		switch strings.ToLower(label.GetName()) {
+		case traceIDLabel:
+			traceID, err = hex.DecodeString(label.GetValue())
+		case spanIDLabel:
+			spanID, err = hex.DecodeString(label.GetValue())
+		default:
+			attrs = append(attrs, attribute.String(label.GetName(), label.GetValue()))
+			continue
+		}
+		if err != nil {
+			// Not a valid ID, keep it as an attribute.
+			attrs = append(attrs, attribute.String(label.GetName(), label.GetValue()))
+		}
+	}
+	e := metricdata.Exemplar[float64]{
+		FilteredAttributes: attrs,
+		Value:              exemplar.GetValue(),
+		TraceID:            traceID,
+		SpanID:             spanID,
+	}
+	if ts := exemplar.GetTimestamp(); ts != nil {
+		e.Time = ts.AsTime()
+	}
+	return e
+}
+
+// timestamp returns the time of m if it was set explicitly, otherwise now.
+func timestamp(m *dto.Metric, now time.Time) time.Time {
+	if m.TimestampMs == nil {
+		return now
+	}
+	return time.UnixMilli(m.GetTimestampMs())
+}
+
+type multierror []error
+
+func (e multierror) Error() string {
+	es := make([]string, len(e))
+	for i, err := range e {
+		es[i] = err.Error()
+	}
+	return strings.Join(es, "; ")
+}
+
+// Unwrap returns the first error.
+func (e multierror) Unwrap() error {
+	if len(e) == 0 {
+		return nil
+	}
+	return e[0]
+}
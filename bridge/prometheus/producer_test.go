@@ -0,0 +1,360 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus // import "go.opentelemetry.io/otel/bridge/prometheus"
+
+import (
+	"context"
+	"errors"
+	"log"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+)
+
+const (
+	traceIDStr = "4bf92f3577b34da6a3ce929d0e0e4736"
+	spanIDStr  = "00f067aa0ba902b7"
+)
+
+var (
+	traceID = []byte{0x4b, 0xf9, 0x2f, 0x35, 0x77, 0xb3, 0x4d, 0xa6, 0xa3, 0xce, 0x92, 0x9d, 0x0e, 0x0e, 0x47, 0x36}
+	spanID  = []byte{0x00, 0xf0, 0x67, 0xaa, 0x0b, 0xa9, 0x02, 0xb7}
+)
+
+// handleErrors appends the errors handled during the test to handled. Once
+// the test ends they are logged.
+func handleErrors(t *testing.T, handled *[]error) {
+	otel.SetErrorHandler(otel.ErrorHandlerFunc(func(err error) {
+		*handled = append(*handled, err)
+	}))
+	t.Cleanup(func() {
+		otel.SetErrorHandler(otel.ErrorHandlerFunc(func(err error) {
+			log.Print(err)
+		}))
+	})
+}
+
+func TestProduce(t *testing.T) {
+	testCases := []struct {
+		name     string
+		testFn   func(*prometheus.Registry)
+		expected []metricdata.ScopeMetrics
+		wantErr  error
+	}{
+		{
+			name: "no metrics registered",
+		},
+		{
+			name: "gauge",
+			testFn: func(reg *prometheus.Registry) {
+				metric := prometheus.NewGauge(prometheus.GaugeOpts{
+					Name:        "test_gauge_metric",
+					Help:        "A gauge metric for testing",
+					ConstLabels: prometheus.Labels(map[string]string{"foo": "bar"}),
+				})
+				reg.MustRegister(metric)
+				metric.Set(123.4)
+			},
+			expected: []metricdata.ScopeMetrics{{
+				Scope: instrumentation.Scope{Name: scopeName},
+				Metrics: []metricdata.Metrics{{
+					Name:        "test_gauge_metric",
+					Description: "A gauge metric for testing",
+					Data: metricdata.Gauge[float64]{
+						DataPoints: []metricdata.DataPoint[float64]{{
+							Attributes: attribute.NewSet(attribute.String("foo", "bar")),
+							Value:      123.4,
+						}},
+					},
+				}},
+			}},
+		},
+		{
+			name: "untyped",
+			testFn: func(reg *prometheus.Registry) {
+				metric := prometheus.NewUntypedFunc(prometheus.UntypedOpts{
+					Name: "test_untyped_metric",
+					Help: "An untyped metric for testing",
+				}, func() float64 { return 4.2 })
+				reg.MustRegister(metric)
+			},
+			expected: []metricdata.ScopeMetrics{{
+				Scope: instrumentation.Scope{Name: scopeName},
+				Metrics: []metricdata.Metrics{{
+					Name:        "test_untyped_metric",
+					Description: "An untyped metric for testing",
+					Data: metricdata.Gauge[float64]{
+						DataPoints: []metricdata.DataPoint[float64]{{
+							Attributes: *attribute.EmptySet(),
+							Value:      4.2,
+						}},
+					},
+				}},
+			}},
+		},
+		{
+			name: "counter",
+			testFn: func(reg *prometheus.Registry) {
+				metric := prometheus.NewCounterVec(prometheus.CounterOpts{
+					Name: "test_counter_metric",
+					Help: "A counter metric for testing",
+				}, []string{"user"})
+				reg.MustRegister(metric)
+				metric.WithLabelValues("alice").Add(1)
+				metric.WithLabelValues("bob").(prometheus.ExemplarAdder).AddWithExemplar(
+					245.3, prometheus.Labels{
+						"trace_id":        traceIDStr,
+						"span_id":         spanIDStr,
+						"other_attribute": "abcd",
+					},
+				)
+			},
+			expected: []metricdata.ScopeMetrics{{
+				Scope: instrumentation.Scope{Name: scopeName},
+				Metrics: []metricdata.Metrics{{
+					Name:        "test_counter_metric",
+					Description: "A counter metric for testing",
+					Data: metricdata.Sum[float64]{
+						Temporality: metricdata.CumulativeTemporality,
+						IsMonotonic: true,
+						DataPoints: []metricdata.DataPoint[float64]{
+							{
+								Attributes: attribute.NewSet(attribute.String("user", "alice")),
+								Value:      1,
+							},
+							{
+								Attributes: attribute.NewSet(attribute.String("user", "bob")),
+								Value:      245.3,
+								Exemplars: []metricdata.Exemplar[float64]{{
+									FilteredAttributes: []attribute.KeyValue{
+										attribute.String("other_attribute", "abcd"),
+									},
+									Value:   245.3,
+									TraceID: traceID,
+									SpanID:  spanID,
+								}},
+							},
+						},
+					},
+				}},
+			}},
+		},
+		{
+			name: "histogram",
+			testFn: func(reg *prometheus.Registry) {
+				metric := prometheus.NewHistogram(prometheus.HistogramOpts{
+					Name:        "test_histogram_metric",
+					Help:        "A histogram metric for testing",
+					Buckets:     []float64{1, 5, 10},
+					ConstLabels: prometheus.Labels(map[string]string{"foo": "bar"}),
+				})
+				reg.MustRegister(metric)
+				metric.Observe(0.5)
+				metric.Observe(4)
+				metric.Observe(4)
+				metric.Observe(12)
+			},
+			expected: []metricdata.ScopeMetrics{{
+				Scope: instrumentation.Scope{Name: scopeName},
+				Metrics: []metricdata.Metrics{{
+					Name:        "test_histogram_metric",
+					Description: "A histogram metric for testing",
+					Data: metricdata.Histogram{
+						Temporality: metricdata.CumulativeTemporality,
+						DataPoints: []metricdata.HistogramDataPoint{{
+							Attributes:   attribute.NewSet(attribute.String("foo", "bar")),
+							Count:        4,
+							Sum:          20.5,
+							Bounds:       []float64{1, 5, 10},
+							BucketCounts: []uint64{1, 2, 0, 1},
+						}},
+					},
+				}},
+			}},
+		},
+		{
+			name: "summary",
+			testFn: func(reg *prometheus.Registry) {
+				metric := prometheus.NewSummary(prometheus.SummaryOpts{
+					Name:        "test_summary_metric",
+					Help:        "A summary metric for testing",
+					Objectives:  map[float64]float64{0.5: 0.05, 0.9: 0.01},
+					ConstLabels: prometheus.Labels(map[string]string{"foo": "bar"}),
+				})
+				reg.MustRegister(metric)
+				metric.Observe(1)
+				metric.Observe(3)
+			},
+			expected: []metricdata.ScopeMetrics{{
+				Scope: instrumentation.Scope{Name: scopeName},
+				Metrics: []metricdata.Metrics{{
+					Name:        "test_summary_metric",
+					Description: "A summary metric for testing",
+					Data: metricdata.Summary{
+						DataPoints: []metricdata.SummaryDataPoint{{
+							Attributes: attribute.NewSet(attribute.String("foo", "bar")),
+							Count:      2,
+							Sum:        4,
+							QuantileValues: []metricdata.QuantileValue{
+								{Quantile: 0.5, Value: 1},
+								{Quantile: 0.9, Value: 3},
+							},
+						}},
+					},
+				}},
+			}},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			var handled []error
+			handleErrors(t, &handled)
+
+			reg := prometheus.NewRegistry()
+			if tt.testFn != nil {
+				tt.testFn(reg)
+			}
+			rdr := metric.NewManualReader(metric.WithProducer(NewMetricProducer(WithGatherer(reg))))
+			_ = metric.NewMeterProvider(metric.WithReader(rdr))
+			output, err := rdr.Collect(context.Background())
+			require.NoError(t, err)
+
+			if tt.wantErr != nil {
+				require.Len(t, handled, 1)
+				assert.ErrorIs(t, handled[0], tt.wantErr)
+			} else {
+				assert.Empty(t, handled)
+			}
+			require.Len(t, output.ScopeMetrics, len(tt.expected))
+			for i := range output.ScopeMetrics {
+				metricdatatest.AssertEqual(t, tt.expected[i], output.ScopeMetrics[i], metricdatatest.IgnoreTimestamp())
+			}
+		})
+	}
+}
+
+func TestProduceMultipleGatherers(t *testing.T) {
+	reg1 := prometheus.NewRegistry()
+	c1 := prometheus.NewCounter(prometheus.CounterOpts{Name: "counter_1", Help: "first"})
+	reg1.MustRegister(c1)
+	c1.Inc()
+
+	reg2 := prometheus.NewRegistry()
+	c2 := prometheus.NewCounter(prometheus.CounterOpts{Name: "counter_2", Help: "second"})
+	reg2.MustRegister(c2)
+	c2.Add(2)
+
+	p := NewMetricProducer(WithGatherer(reg1), WithGatherer(reg2))
+	output, err := p.Produce(context.Background())
+	require.NoError(t, err)
+	require.Len(t, output, 1)
+	require.Len(t, output[0].Metrics, 2)
+	assert.Equal(t, "counter_1", output[0].Metrics[0].Name)
+	assert.Equal(t, "counter_2", output[0].Metrics[1].Name)
+}
+
+type gathererFunc func() ([]*dto.MetricFamily, error)
+
+func (f gathererFunc) Gather() ([]*dto.MetricFamily, error) { return f() }
+
+func TestProduceGatherError(t *testing.T) {
+	var handled []error
+	handleErrors(t, &handled)
+
+	errGather := errors.New("gather failed")
+	partial := gathererFunc(func() ([]*dto.MetricFamily, error) {
+		return []*dto.MetricFamily{{
+			Name: proto.String("partial_gauge"),
+			Type: dto.MetricType_GAUGE.Enum(),
+			Metric: []*dto.Metric{{
+				Gauge: &dto.Gauge{Value: proto.Float64(1)},
+			}},
+		}}, errGather
+	})
+
+	output, err := NewMetricProducer(WithGatherer(partial)).Produce(context.Background())
+	require.NoError(t, err)
+	require.Len(t, output, 1)
+	require.Len(t, output[0].Metrics, 1)
+	assert.Equal(t, "partial_gauge", output[0].Metrics[0].Name)
+	require.Len(t, handled, 1)
+	assert.ErrorIs(t, handled[0], errGather)
+}
+
+func TestProduceUnsupportedType(t *testing.T) {
+	var handled []error
+	handleErrors(t, &handled)
+
+	unknown := gathererFunc(func() ([]*dto.MetricFamily, error) {
+		return []*dto.MetricFamily{{
+			Name: proto.String("unknown_metric"),
+			Type: dto.MetricType(-1).Enum(),
+			Metric: []*dto.Metric{{
+				Gauge: &dto.Gauge{Value: proto.Float64(1)},
+			}},
+		}}, nil
+	})
+
+	output, err := NewMetricProducer(WithGatherer(unknown)).Produce(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, output)
+	require.Len(t, handled, 1)
+	assert.ErrorIs(t, handled[0], errUnsupportedType)
+}
+
+func TestConvertLabels(t *testing.T) {
+	labels := []*dto.LabelPair{
+		{Name: proto.String("b"), Value: proto.String("2")},
+		{Name: proto.String("a"), Value: proto.String("1")},
+	}
+	want := attribute.NewSet(attribute.String("a", "1"), attribute.String("b", "2"))
+	assert.Equal(t, want, convertLabels(labels))
+	assert.Equal(t, *attribute.EmptySet(), convertLabels(nil))
+}
+
+func TestTimestamp(t *testing.T) {
+	now := time.Now()
+	assert.Equal(t, now, timestamp(&dto.Metric{}, now))
+
+	ts := time.UnixMilli(1_000_000)
+	m := &dto.Metric{TimestampMs: proto.Int64(ts.UnixMilli())}
+	assert.Equal(t, ts, timestamp(m, now))
+}
+
+func TestConvertBucketsWithInf(t *testing.T) {
+	buckets := []*dto.Bucket{
+		{UpperBound: proto.Float64(1), CumulativeCount: proto.Uint64(2)},
+		{UpperBound: proto.Float64(2), CumulativeCount: proto.Uint64(3)},
+		{UpperBound: proto.Float64(math.Inf(1)), CumulativeCount: proto.Uint64(5)},
+	}
+	bounds, counts, exemplars := convertBuckets(buckets, 5)
+	assert.Equal(t, []float64{1, 2}, bounds)
+	assert.Equal(t, []uint64{2, 1, 2}, counts)
+	assert.Empty(t, exemplars)
+}
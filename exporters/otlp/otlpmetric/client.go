@@ -16,6 +16,7 @@ package otlpmetric // import "go.opentelemetry.io/otel/exporters/otlp/otlpmetric
 
 import (
 	"context"
+	"time"
 
 	"go.opentelemetry.io/otel/sdk/metric/aggregation"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
@@ -56,3 +57,12 @@ type Client interface {
 	// after this is called so the Client can be garbage collected.
 	Shutdown(context.Context) error
 }
+
+// ExportObserver is notified of the exports made by a Client. It can be used
+// to report the operation of a Client, e.g. as metrics.
+type ExportObserver interface {
+	// ExportDone is called when an export completes with the duration of
+	// the export, including retries, and the error it failed with, if any.
+	// It needs to be safe to call concurrently.
+	ExportDone(ctx context.Context, duration time.Duration, err error)
+}
@@ -17,6 +17,7 @@ package oconf // import "go.opentelemetry.io/otel/exporters/otlp/otlpmetric/inte
 import (
 	"crypto/tls"
 	"crypto/x509"
+	"errors"
 	"net/url"
 	"os"
 	"path"
@@ -24,6 +25,10 @@ import (
 	"time"
 
 	"go.opentelemetry.io/otel/exporters/otlp/internal/envconfig"
+	"go.opentelemetry.io/otel/internal/global"
+	"go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/view"
 )
 
 // DefaultEnvOptionsReader is the default environments reader.
@@ -96,6 +101,7 @@ func getOptionsFromEnv() []GenericOption {
 		WithEnvCompression("METRICS_COMPRESSION", func(c Compression) { opts = append(opts, WithCompression(c)) }),
 		envconfig.WithDuration("TIMEOUT", func(d time.Duration) { opts = append(opts, WithTimeout(d)) }),
 		envconfig.WithDuration("METRICS_TIMEOUT", func(d time.Duration) { opts = append(opts, WithTimeout(d)) }),
+		withEnvTemporalityPreference("METRICS_TEMPORALITY_PREFERENCE", func(t metric.TemporalitySelector) { opts = append(opts, WithTemporalitySelector(t)) }),
 	)
 
 	return opts
@@ -148,3 +154,52 @@ func withTLSConfig(c *tls.Config, fn func(*tls.Config)) func(e *envconfig.EnvOpt
 		}
 	}
 }
+
+var errUnknownTemporality = errors.New("unknown temporality preference")
+
+// withEnvTemporalityPreference retrieves the specified config and passes it
+// to fn as a TemporalitySelector. Unknown values are reported and ignored.
+func withEnvTemporalityPreference(n string, fn func(metric.TemporalitySelector)) func(e *envconfig.EnvOptionsReader) {
+	return func(e *envconfig.EnvOptionsReader) {
+		if v, ok := e.GetEnvValue(n); ok {
+			switch strings.ToLower(strings.TrimSpace(v)) {
+			case "cumulative":
+				fn(cumulativeTemporality)
+			case "delta":
+				fn(deltaTemporality)
+			case "lowmemory":
+				fn(lowMemory)
+			default:
+				global.Error(errUnknownTemporality, "using default temporality", "value", v)
+			}
+		}
+	}
+}
+
+func cumulativeTemporality(view.InstrumentKind) metricdata.Temporality {
+	return metricdata.CumulativeTemporality
+}
+
+// deltaTemporality uses delta temporality for monotonic instruments and
+// cumulative temporality for all others.
+func deltaTemporality(ik view.InstrumentKind) metricdata.Temporality {
+	switch ik {
+	case view.SyncCounter, view.SyncHistogram, view.AsyncCounter:
+		return metricdata.DeltaTemporality
+	default:
+		return metricdata.CumulativeTemporality
+	}
+}
+
+// lowMemory uses delta temporality for synchronous monotonic instruments and
+// cumulative temporality for all others. This avoids retaining state for
+// synchronous instruments while not requiring asynchronous instruments to
+// track previous observations.
+func lowMemory(ik view.InstrumentKind) metricdata.Temporality {
+	switch ik {
+	case view.SyncCounter, view.SyncHistogram:
+		return metricdata.DeltaTemporality
+	default:
+		return metricdata.CumulativeTemporality
+	}
+}
@@ -27,6 +27,7 @@ import (
 
 	"go.opentelemetry.io/otel/exporters/otlp/internal"
 	"go.opentelemetry.io/otel/exporters/otlp/internal/retry"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric"
 	"go.opentelemetry.io/otel/internal/global"
 	"go.opentelemetry.io/otel/sdk/metric"
 	"go.opentelemetry.io/otel/sdk/metric/aggregation"
@@ -77,6 +78,10 @@ type (
 		ServiceConfig      string
 		DialOptions        []grpc.DialOption
 		GRPCConn           *grpc.ClientConn
+
+		// ExportObserver is notified of the exports made by the client.
+		// If nil, nothing is observed.
+		ExportObserver otlpmetric.ExportObserver
 	}
 )
 
@@ -334,6 +339,13 @@ func WithTemporalitySelector(selector metric.TemporalitySelector) GenericOption
 	})
 }
 
+func WithExportObserver(o otlpmetric.ExportObserver) GenericOption {
+	return newGenericOption(func(cfg Config) Config {
+		cfg.ExportObserver = o
+		return cfg
+	})
+}
+
 func WithAggregationSelector(selector metric.AggregationSelector) GenericOption {
 	// Deep copy and validate before using.
 	wrapped := func(ik view.InstrumentKind) aggregation.Aggregation {
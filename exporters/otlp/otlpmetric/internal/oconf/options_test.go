@@ -23,6 +23,7 @@ import (
 
 	"go.opentelemetry.io/otel/exporters/otlp/internal/envconfig"
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/internal/oconf"
+	"go.opentelemetry.io/otel/sdk/metric"
 	"go.opentelemetry.io/otel/sdk/metric/aggregation"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 	"go.opentelemetry.io/otel/sdk/metric/view"
@@ -402,6 +403,81 @@ func TestConfigs(t *testing.T) {
 				assert.Equal(t, metricdata.DeltaTemporality, got(undefinedKind))
 			},
 		},
+		{
+			name: "Test Environment Cumulative Temporality Preference",
+			env: map[string]string{
+				"OTEL_EXPORTER_OTLP_METRICS_TEMPORALITY_PREFERENCE": "cumulative",
+			},
+			asserts: func(t *testing.T, c *oconf.Config, grpcOption bool) {
+				assertTemporalities(t, c.Metrics.TemporalitySelector, temporalities{
+					view.SyncCounter:        metricdata.CumulativeTemporality,
+					view.SyncUpDownCounter:  metricdata.CumulativeTemporality,
+					view.SyncHistogram:      metricdata.CumulativeTemporality,
+					view.AsyncCounter:       metricdata.CumulativeTemporality,
+					view.AsyncUpDownCounter: metricdata.CumulativeTemporality,
+					view.AsyncGauge:         metricdata.CumulativeTemporality,
+				})
+			},
+		},
+		{
+			name: "Test Environment Delta Temporality Preference",
+			env: map[string]string{
+				"OTEL_EXPORTER_OTLP_METRICS_TEMPORALITY_PREFERENCE": "Delta",
+			},
+			asserts: func(t *testing.T, c *oconf.Config, grpcOption bool) {
+				assertTemporalities(t, c.Metrics.TemporalitySelector, temporalities{
+					view.SyncCounter:        metricdata.DeltaTemporality,
+					view.SyncUpDownCounter:  metricdata.CumulativeTemporality,
+					view.SyncHistogram:      metricdata.DeltaTemporality,
+					view.AsyncCounter:       metricdata.DeltaTemporality,
+					view.AsyncUpDownCounter: metricdata.CumulativeTemporality,
+					view.AsyncGauge:         metricdata.CumulativeTemporality,
+				})
+			},
+		},
+		{
+			name: "Test Environment LowMemory Temporality Preference",
+			env: map[string]string{
+				"OTEL_EXPORTER_OTLP_METRICS_TEMPORALITY_PREFERENCE": "lowmemory",
+			},
+			asserts: func(t *testing.T, c *oconf.Config, grpcOption bool) {
+				assertTemporalities(t, c.Metrics.TemporalitySelector, temporalities{
+					view.SyncCounter:        metricdata.DeltaTemporality,
+					view.SyncUpDownCounter:  metricdata.CumulativeTemporality,
+					view.SyncHistogram:      metricdata.DeltaTemporality,
+					view.AsyncCounter:       metricdata.CumulativeTemporality,
+					view.AsyncUpDownCounter: metricdata.CumulativeTemporality,
+					view.AsyncGauge:         metricdata.CumulativeTemporality,
+				})
+			},
+		},
+		{
+			name: "Test Environment Invalid Temporality Preference",
+			env: map[string]string{
+				"OTEL_EXPORTER_OTLP_METRICS_TEMPORALITY_PREFERENCE": "invalid",
+			},
+			asserts: func(t *testing.T, c *oconf.Config, grpcOption bool) {
+				assertTemporalities(t, c.Metrics.TemporalitySelector, temporalities{
+					view.SyncCounter:   metricdata.CumulativeTemporality,
+					view.SyncHistogram: metricdata.CumulativeTemporality,
+				})
+			},
+		},
+		{
+			name: "Test Mixed Environment and With Temporality Selector",
+			env: map[string]string{
+				"OTEL_EXPORTER_OTLP_METRICS_TEMPORALITY_PREFERENCE": "cumulative",
+			},
+			opts: []oconf.GenericOption{
+				oconf.WithTemporalitySelector(deltaSelector),
+			},
+			asserts: func(t *testing.T, c *oconf.Config, grpcOption bool) {
+				assertTemporalities(t, c.Metrics.TemporalitySelector, temporalities{
+					view.SyncUpDownCounter: metricdata.DeltaTemporality,
+					view.AsyncGauge:        metricdata.DeltaTemporality,
+				})
+			},
+		},
 
 		// Aggregation Selector Tests
 		{
@@ -449,6 +525,15 @@ func deltaSelector(view.InstrumentKind) metricdata.Temporality {
 	return metricdata.DeltaTemporality
 }
 
+type temporalities map[view.InstrumentKind]metricdata.Temporality
+
+func assertTemporalities(t *testing.T, got metric.TemporalitySelector, want temporalities) {
+	t.Helper()
+	for ik, temporality := range want {
+		assert.Equalf(t, temporality, got(ik), "instrument kind %d", ik)
+	}
+}
+
 func asHTTPOptions(opts []oconf.GenericOption) []oconf.HTTPOption {
 	converted := make([]oconf.HTTPOption, len(opts))
 	for i, o := range opts {
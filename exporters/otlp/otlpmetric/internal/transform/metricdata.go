@@ -97,6 +97,10 @@ func metric(m metricdata.Metrics) (*mpb.Metric, error) {
 		out.Data, err = Sum[float64](a)
 	case metricdata.Histogram:
 		out.Data, err = Histogram(a)
+	case metricdata.ExponentialHistogram:
+		out.Data, err = ExponentialHistogram(a)
+	case metricdata.Summary:
+		out.Data = Summary(a)
 	default:
 		return out, fmt.Errorf("%w: %T", errUnknownAggregation, a)
 	}
@@ -147,6 +151,7 @@ func DataPoints[N int64 | float64](dPts []metricdata.DataPoint[N]) []*mpb.Number
 				AsDouble: v,
 			}
 		}
+		ndp.Exemplars = Exemplars(dPt.Exemplars)
 		out = append(out, ndp)
 	}
 	return out
@@ -184,11 +189,130 @@ func HistogramDataPoints(dPts []metricdata.HistogramDataPoint) []*mpb.HistogramD
 			ExplicitBounds:    dPt.Bounds,
 			Min:               dPt.Min,
 			Max:               dPt.Max,
+			Exemplars:         Exemplars(dPt.Exemplars),
 		})
 	}
 	return out
 }
 
+// ExponentialHistogram returns an OTLP Metric_ExponentialHistogram generated
+// from h. An error is returned with a partial Metric_ExponentialHistogram if
+// the temporality of h is unknown.
+func ExponentialHistogram(h metricdata.ExponentialHistogram) (*mpb.Metric_ExponentialHistogram, error) {
+	t, err := Temporality(h.Temporality)
+	if err != nil {
+		return nil, err
+	}
+	return &mpb.Metric_ExponentialHistogram{
+		ExponentialHistogram: &mpb.ExponentialHistogram{
+			AggregationTemporality: t,
+			DataPoints:             ExponentialHistogramDataPoints(h.DataPoints),
+		},
+	}, nil
+}
+
+// ExponentialHistogramDataPoints returns a slice of OTLP
+// ExponentialHistogramDataPoint generated from dPts.
+func ExponentialHistogramDataPoints(dPts []metricdata.ExponentialHistogramDataPoint) []*mpb.ExponentialHistogramDataPoint {
+	out := make([]*mpb.ExponentialHistogramDataPoint, 0, len(dPts))
+	for _, dPt := range dPts {
+		sum := dPt.Sum
+		out = append(out, &mpb.ExponentialHistogramDataPoint{
+			Attributes:        AttrIter(dPt.Attributes.Iter()),
+			StartTimeUnixNano: uint64(dPt.StartTime.UnixNano()),
+			TimeUnixNano:      uint64(dPt.Time.UnixNano()),
+			Count:             dPt.Count,
+			Sum:               &sum,
+			Scale:             dPt.Scale,
+			ZeroCount:         dPt.ZeroCount,
+			Positive:          ExponentialHistogramDataPointBuckets(dPt.PositiveBucket),
+			Negative:          ExponentialHistogramDataPointBuckets(dPt.NegativeBucket),
+			Min:               dPt.Min,
+			Max:               dPt.Max,
+			Exemplars:         Exemplars(dPt.Exemplars),
+		})
+	}
+	return out
+}
+
+// ExponentialHistogramDataPointBuckets returns an OTLP
+// ExponentialHistogramDataPoint_Buckets generated from bucket.
+func ExponentialHistogramDataPointBuckets(bucket metricdata.ExponentialBucket) *mpb.ExponentialHistogramDataPoint_Buckets {
+	return &mpb.ExponentialHistogramDataPoint_Buckets{
+		Offset:       bucket.Offset,
+		BucketCounts: bucket.Counts,
+	}
+}
+
+// Summary returns an OTLP Metric_Summary generated from s.
+func Summary(s metricdata.Summary) *mpb.Metric_Summary {
+	return &mpb.Metric_Summary{
+		Summary: &mpb.Summary{
+			DataPoints: SummaryDataPoints(s.DataPoints),
+		},
+	}
+}
+
+// SummaryDataPoints returns a slice of OTLP SummaryDataPoint generated from
+// dPts.
+func SummaryDataPoints(dPts []metricdata.SummaryDataPoint) []*mpb.SummaryDataPoint {
+	out := make([]*mpb.SummaryDataPoint, 0, len(dPts))
+	for _, dPt := range dPts {
+		out = append(out, &mpb.SummaryDataPoint{
+			Attributes:        AttrIter(dPt.Attributes.Iter()),
+			StartTimeUnixNano: uint64(dPt.StartTime.UnixNano()),
+			TimeUnixNano:      uint64(dPt.Time.UnixNano()),
+			Count:             dPt.Count,
+			Sum:               dPt.Sum,
+			QuantileValues:    QuantileValues(dPt.QuantileValues),
+		})
+	}
+	return out
+}
+
+// QuantileValues returns a slice of OTLP SummaryDataPoint_ValueAtQuantile
+// generated from quantiles.
+func QuantileValues(quantiles []metricdata.QuantileValue) []*mpb.SummaryDataPoint_ValueAtQuantile {
+	out := make([]*mpb.SummaryDataPoint_ValueAtQuantile, 0, len(quantiles))
+	for _, q := range quantiles {
+		out = append(out, &mpb.SummaryDataPoint_ValueAtQuantile{
+			Quantile: q.Quantile,
+			Value:    q.Value,
+		})
+	}
+	return out
+}
+
+// Exemplars returns a slice of OTLP Exemplars generated from exemplars. If
+// exemplars is empty, nil is returned.
+func Exemplars[N int64 | float64](exemplars []metricdata.Exemplar[N]) []*mpb.Exemplar {
+	if len(exemplars) == 0 {
+		return nil
+	}
+
+	out := make([]*mpb.Exemplar, 0, len(exemplars))
+	for _, e := range exemplars {
+		pe := &mpb.Exemplar{
+			FilteredAttributes: KeyValues(e.FilteredAttributes),
+			TimeUnixNano:       uint64(e.Time.UnixNano()),
+			SpanId:             e.SpanID,
+			TraceId:            e.TraceID,
+		}
+		switch v := any(e.Value).(type) {
+		case int64:
+			pe.Value = &mpb.Exemplar_AsInt{
+				AsInt: v,
+			}
+		case float64:
+			pe.Value = &mpb.Exemplar_AsDouble{
+				AsDouble: v,
+			}
+		}
+		out = append(out, pe)
+	}
+	return out
+}
+
 // Temporality returns an OTLP AggregationTemporality generated from t. If t
 // is unknown, an error is returned along with the invalid
 // AggregationTemporality_AGGREGATION_TEMPORALITY_UNSPECIFIED.
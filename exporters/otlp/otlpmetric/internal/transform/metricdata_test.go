@@ -51,6 +51,39 @@ var (
 		Value: &cpb.AnyValue_StringValue{StringValue: "bob"},
 	}}
 
+	spanID  = []byte{0, 0, 0, 0, 0, 0, 0, 1}
+	traceID = []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1}
+
+	otelExemplarsInt64 = []metricdata.Exemplar[int64]{{
+		FilteredAttributes: []attribute.KeyValue{attribute.String("user", "bob")},
+		Time:               end,
+		Value:              1,
+		SpanID:             spanID,
+		TraceID:            traceID,
+	}}
+	otelExemplarsFloat64 = []metricdata.Exemplar[float64]{{
+		FilteredAttributes: []attribute.KeyValue{attribute.String("user", "bob")},
+		Time:               end,
+		Value:              1.0,
+		SpanID:             spanID,
+		TraceID:            traceID,
+	}}
+
+	pbExemplarsInt64 = []*mpb.Exemplar{{
+		FilteredAttributes: []*cpb.KeyValue{pbBob},
+		TimeUnixNano:       uint64(end.UnixNano()),
+		Value:              &mpb.Exemplar_AsInt{AsInt: 1},
+		SpanId:             spanID,
+		TraceId:            traceID,
+	}}
+	pbExemplarsFloat64 = []*mpb.Exemplar{{
+		FilteredAttributes: []*cpb.KeyValue{pbBob},
+		TimeUnixNano:       uint64(end.UnixNano()),
+		Value:              &mpb.Exemplar_AsDouble{AsDouble: 1.0},
+		SpanId:             spanID,
+		TraceId:            traceID,
+	}}
+
 	minA, maxA, sumA = 2.0, 4.0, 90.0
 	minB, maxB, sumB = 4.0, 150.0, 234.0
 	otelHDP          = []metricdata.HistogramDataPoint{{
@@ -63,6 +96,7 @@ var (
 		Min:          &minA,
 		Max:          &maxA,
 		Sum:          sumA,
+		Exemplars:    otelExemplarsFloat64,
 	}, {
 		Attributes:   bob,
 		StartTime:    start,
@@ -85,6 +119,7 @@ var (
 		BucketCounts:      []uint64{0, 30, 0},
 		Min:               &minA,
 		Max:               &maxA,
+		Exemplars:         pbExemplarsFloat64,
 	}, {
 		Attributes:        []*cpb.KeyValue{pbBob},
 		StartTimeUnixNano: uint64(start.UnixNano()),
@@ -112,12 +147,131 @@ var (
 		DataPoints:             pbHDP,
 	}
 
+	otelEHDP = []metricdata.ExponentialHistogramDataPoint{{
+		Attributes: alice,
+		StartTime:  start,
+		Time:       end,
+		Count:      30,
+		Scale:      2,
+		ZeroCount:  10,
+		PositiveBucket: metricdata.ExponentialBucket{
+			Offset: 1,
+			Counts: []uint64{0, 20},
+		},
+		Min:       &minA,
+		Max:       &maxA,
+		Sum:       sumA,
+		Exemplars: otelExemplarsFloat64,
+	}, {
+		Attributes: bob,
+		StartTime:  start,
+		Time:       end,
+		Count:      3,
+		Scale:      4,
+		ZeroCount:  1,
+		NegativeBucket: metricdata.ExponentialBucket{
+			Offset: -1,
+			Counts: []uint64{1, 1},
+		},
+		Min: &minB,
+		Max: &maxB,
+		Sum: sumB,
+	}}
+
+	pbEHDP = []*mpb.ExponentialHistogramDataPoint{{
+		Attributes:        []*cpb.KeyValue{pbAlice},
+		StartTimeUnixNano: uint64(start.UnixNano()),
+		TimeUnixNano:      uint64(end.UnixNano()),
+		Count:             30,
+		Sum:               &sumA,
+		Scale:             2,
+		ZeroCount:         10,
+		Positive: &mpb.ExponentialHistogramDataPoint_Buckets{
+			Offset:       1,
+			BucketCounts: []uint64{0, 20},
+		},
+		Negative:  &mpb.ExponentialHistogramDataPoint_Buckets{},
+		Min:       &minA,
+		Max:       &maxA,
+		Exemplars: pbExemplarsFloat64,
+	}, {
+		Attributes:        []*cpb.KeyValue{pbBob},
+		StartTimeUnixNano: uint64(start.UnixNano()),
+		TimeUnixNano:      uint64(end.UnixNano()),
+		Count:             3,
+		Sum:               &sumB,
+		Scale:             4,
+		ZeroCount:         1,
+		Positive:          &mpb.ExponentialHistogramDataPoint_Buckets{},
+		Negative: &mpb.ExponentialHistogramDataPoint_Buckets{
+			Offset:       -1,
+			BucketCounts: []uint64{1, 1},
+		},
+		Min: &minB,
+		Max: &maxB,
+	}}
+
+	otelExpoHist = metricdata.ExponentialHistogram{
+		Temporality: metricdata.DeltaTemporality,
+		DataPoints:  otelEHDP,
+	}
+	otelExpoHistInvalid = metricdata.ExponentialHistogram{
+		Temporality: invalidTemporality,
+		DataPoints:  otelEHDP,
+	}
+
+	pbExpoHist = &mpb.ExponentialHistogram{
+		AggregationTemporality: mpb.AggregationTemporality_AGGREGATION_TEMPORALITY_DELTA,
+		DataPoints:             pbEHDP,
+	}
+
+	otelSDP = []metricdata.SummaryDataPoint{{
+		Attributes: alice,
+		StartTime:  start,
+		Time:       end,
+		Count:      30,
+		Sum:        sumA,
+		QuantileValues: []metricdata.QuantileValue{
+			{Quantile: 0.5, Value: 2.0},
+			{Quantile: 0.99, Value: 10.0},
+		},
+	}, {
+		Attributes: bob,
+		StartTime:  start,
+		Time:       end,
+		Count:      3,
+		Sum:        sumB,
+	}}
+
+	pbSDP = []*mpb.SummaryDataPoint{{
+		Attributes:        []*cpb.KeyValue{pbAlice},
+		StartTimeUnixNano: uint64(start.UnixNano()),
+		TimeUnixNano:      uint64(end.UnixNano()),
+		Count:             30,
+		Sum:               sumA,
+		QuantileValues: []*mpb.SummaryDataPoint_ValueAtQuantile{
+			{Quantile: 0.5, Value: 2.0},
+			{Quantile: 0.99, Value: 10.0},
+		},
+	}, {
+		Attributes:        []*cpb.KeyValue{pbBob},
+		StartTimeUnixNano: uint64(start.UnixNano()),
+		TimeUnixNano:      uint64(end.UnixNano()),
+		Count:             3,
+		Sum:               sumB,
+		QuantileValues:    []*mpb.SummaryDataPoint_ValueAtQuantile{},
+	}}
+
+	otelSummary = metricdata.Summary{DataPoints: otelSDP}
+
+	pbSummary = &mpb.Summary{DataPoints: pbSDP}
+
 	otelDPtsInt64 = []metricdata.DataPoint[int64]{
-		{Attributes: alice, StartTime: start, Time: end, Value: 1},
+		{Attributes: alice, StartTime: start, Time: end, Value: 1, Exemplars: otelExemplarsInt64},
 		{Attributes: bob, StartTime: start, Time: end, Value: 2},
 	}
 	otelDPtsFloat64 = []metricdata.DataPoint[float64]{
-		{Attributes: alice, StartTime: start, Time: end, Value: 1.0},
+		{Attributes: alice, StartTime: start, Time: end, Value: 1.0, Exemplars: otelExemplarsFloat64},
 		{Attributes: bob, StartTime: start, Time: end, Value: 2.0},
 	}
 
@@ -127,6 +281,7 @@ var (
 			StartTimeUnixNano: uint64(start.UnixNano()),
 			TimeUnixNano:      uint64(end.UnixNano()),
 			Value:             &mpb.NumberDataPoint_AsInt{AsInt: 1},
+			Exemplars:         pbExemplarsInt64,
 		},
 		{
 			Attributes:        []*cpb.KeyValue{pbBob},
@@ -141,6 +296,7 @@ var (
 			StartTimeUnixNano: uint64(start.UnixNano()),
 			TimeUnixNano:      uint64(end.UnixNano()),
 			Value:             &mpb.NumberDataPoint_AsDouble{AsDouble: 1.0},
+			Exemplars:         pbExemplarsFloat64,
 		},
 		{
 			Attributes:        []*cpb.KeyValue{pbBob},
@@ -227,6 +383,24 @@ var (
 			Unit:        unit.Dimensionless,
 			Data:        otelHistInvalid,
 		},
+		{
+			Name:        "exponential-histogram",
+			Description: "Exponential Histogram",
+			Unit:        unit.Dimensionless,
+			Data:        otelExpoHist,
+		},
+		{
+			Name:        "invalid-exponential-histogram",
+			Description: "Invalid exponential histogram",
+			Unit:        unit.Dimensionless,
+			Data:        otelExpoHistInvalid,
+		},
+		{
+			Name:        "summary",
+			Description: "Summary",
+			Unit:        unit.Dimensionless,
+			Data:        otelSummary,
+		},
 		{
 			Name:        "unknown",
 			Description: "Unknown aggregation",
@@ -266,6 +440,18 @@ var (
 			Unit:        string(unit.Dimensionless),
 			Data:        &mpb.Metric_Histogram{Histogram: pbHist},
 		},
+		{
+			Name:        "exponential-histogram",
+			Description: "Exponential Histogram",
+			Unit:        string(unit.Dimensionless),
+			Data:        &mpb.Metric_ExponentialHistogram{ExponentialHistogram: pbExpoHist},
+		},
+		{
+			Name:        "summary",
+			Description: "Summary",
+			Unit:        string(unit.Dimensionless),
+			Data:        &mpb.Metric_Summary{Summary: pbSummary},
+		},
 	}
 
 	otelScopeMetrics = []metricdata.ScopeMetrics{{
@@ -327,8 +513,15 @@ func TestTransformations(t *testing.T) {
 	// opposed to the opposite of testing from the top-down which will obscure
 	// errors deep inside the structs).
 
+	// Exemplars.
+	assert.Nil(t, Exemplars[int64](nil))
+	assert.Equal(t, pbExemplarsInt64, Exemplars(otelExemplarsInt64))
+	assert.Equal(t, pbExemplarsFloat64, Exemplars(otelExemplarsFloat64))
+
 	// DataPoint types.
 	assert.Equal(t, pbHDP, HistogramDataPoints(otelHDP))
+	assert.Equal(t, pbEHDP, ExponentialHistogramDataPoints(otelEHDP))
+	assert.Equal(t, pbSDP, SummaryDataPoints(otelSDP))
 	assert.Equal(t, pbDPtsInt64, DataPoints[int64](otelDPtsInt64))
 	require.Equal(t, pbDPtsFloat64, DataPoints[float64](otelDPtsFloat64))
 
@@ -340,6 +533,15 @@ func TestTransformations(t *testing.T) {
 	assert.ErrorIs(t, err, errUnknownTemporality)
 	assert.Nil(t, h)
 
+	eh, err := ExponentialHistogram(otelExpoHist)
+	assert.NoError(t, err)
+	assert.Equal(t, &mpb.Metric_ExponentialHistogram{ExponentialHistogram: pbExpoHist}, eh)
+	eh, err = ExponentialHistogram(otelExpoHistInvalid)
+	assert.ErrorIs(t, err, errUnknownTemporality)
+	assert.Nil(t, eh)
+
+	assert.Equal(t, &mpb.Metric_Summary{Summary: pbSummary}, Summary(otelSummary))
+
 	s, err := Sum[int64](otelSumInt64)
 	assert.NoError(t, err)
 	assert.Equal(t, &mpb.Metric_Sum{Sum: pbSumInt64}, s)
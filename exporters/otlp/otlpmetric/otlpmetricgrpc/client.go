@@ -61,6 +61,8 @@ type client struct {
 	temporalitySelector metric.TemporalitySelector
 	aggregationSelector metric.AggregationSelector
 
+	observer otlpmetric.ExportObserver
+
 	// ourConn keeps track of where conn was created: true if created here in
 	// NewClient, or false if passed with an option. This is important on
 	// Shutdown as the conn should only be closed if we created it. Otherwise,
@@ -81,6 +83,8 @@ func newClient(ctx context.Context, options ...Option) (otlpmetric.Client, error
 
 		temporalitySelector: cfg.Metrics.TemporalitySelector,
 		aggregationSelector: cfg.Metrics.AggregationSelector,
+
+		observer: cfg.ExportObserver,
 	}
 
 	if len(cfg.Metrics.Headers) > 0 {
@@ -149,7 +153,13 @@ func (c *client) Shutdown(ctx context.Context) error {
 //
 // Retryable errors from the server will be handled according to any
 // RetryConfig the client was created with.
-func (c *client) UploadMetrics(ctx context.Context, protoMetrics *metricpb.ResourceMetrics) error {
+func (c *client) UploadMetrics(ctx context.Context, protoMetrics *metricpb.ResourceMetrics) (err error) {
+	if c.observer != nil {
+		defer func(ctx context.Context, start time.Time) {
+			c.observer.ExportDone(ctx, time.Since(start), err)
+		}(ctx, time.Now())
+	}
+
 	// The otlpmetric.Exporter synchronizes access to client methods, and
 	// ensures this is not called after the Exporter is shutdown. Only thing
 	// to do here is send data.
@@ -23,6 +23,7 @@ import (
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/exporters/otlp/internal/retry"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric"
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/internal/oconf"
 	"go.opentelemetry.io/otel/sdk/metric"
 )
@@ -239,8 +240,18 @@ func WithRetry(settings RetryConfig) Option {
 }
 
 // WithTemporalitySelector sets the TemporalitySelector the client will use to
-// determine the Temporality of an instrument based on its kind. If this option
-// is not used, the client will use the DefaultTemporalitySelector from the
+// determine the Temporality of an instrument based on its kind.
+//
+// If the OTEL_EXPORTER_OTLP_METRICS_TEMPORALITY_PREFERENCE environment
+// variable is set, and this option is not passed, that variable value will be
+// used. Supported values are "cumulative", "delta", and "lowmemory". The
+// "delta" preference uses delta temporality for Counter, Histogram, and
+// asynchronous Counter instruments. The "lowmemory" preference uses delta
+// temporality only for Counter and Histogram instruments. All other
+// instruments use cumulative temporality. Unsupported values are ignored.
+//
+// By default, if the environment variable is not set, and this option is not
+// passed, the client will use the DefaultTemporalitySelector from the
 // go.opentelemetry.io/otel/sdk/metric package.
 func WithTemporalitySelector(selector metric.TemporalitySelector) Option {
 	return wrappedOption{oconf.WithTemporalitySelector(selector)}
@@ -254,3 +265,15 @@ func WithTemporalitySelector(selector metric.TemporalitySelector) Option {
 func WithAggregationSelector(selector metric.AggregationSelector) Option {
 	return wrappedOption{oconf.WithAggregationSelector(selector)}
 }
+
+// WithExportObserver sets the ExportObserver notified of the duration and
+// result of each export made by the client.
+//
+// If the observer reports its observations as telemetry, it should not be
+// exported with this client, otherwise the client reports on the exports of
+// its own reports.
+//
+// By default, if this option is not used, nothing is observed.
+func WithExportObserver(o otlpmetric.ExportObserver) Option {
+	return wrappedOption{oconf.WithExportObserver(o)}
+}
@@ -62,6 +62,8 @@ type client struct {
 
 	temporalitySelector metric.TemporalitySelector
 	aggregationSelector metric.AggregationSelector
+
+	observer otlpmetric.ExportObserver
 }
 
 // Keep it in sync with golang's DefaultTransport from net/http! We
@@ -126,6 +128,8 @@ func newClient(opts ...Option) (otlpmetric.Client, error) {
 
 		temporalitySelector: cfg.Metrics.TemporalitySelector,
 		aggregationSelector: cfg.Metrics.AggregationSelector,
+
+		observer: cfg.ExportObserver,
 	}, nil
 }
 
@@ -157,7 +161,13 @@ func (c *client) Shutdown(ctx context.Context) error {
 //
 // Retryable errors from the server will be handled according to any
 // RetryConfig the client was created with.
-func (c *client) UploadMetrics(ctx context.Context, protoMetrics *metricpb.ResourceMetrics) error {
+func (c *client) UploadMetrics(ctx context.Context, protoMetrics *metricpb.ResourceMetrics) (err error) {
+	if c.observer != nil {
+		defer func(ctx context.Context, start time.Time) {
+			c.observer.ExportDone(ctx, time.Since(start), err)
+		}(ctx, time.Now())
+	}
+
 	// The otlpmetric.Exporter synchronizes access to client methods, and
 	// ensures this is not called after the Exporter is shutdown. Only thing
 	// to do here is send data.
@@ -16,6 +16,7 @@ package otlptrace // import "go.opentelemetry.io/otel/exporters/otlp/otlptrace"
 
 import (
 	"context"
+	"time"
 
 	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
 )
@@ -52,3 +53,12 @@ type Client interface {
 	// DO NOT CHANGE: any modification will not be backwards compatible and
 	// must never be done outside of a new major release.
 }
+
+// ExportObserver is notified of the exports made by a Client. It can be used
+// to report the operation of a Client, e.g. as metrics.
+type ExportObserver interface {
+	// ExportDone is called when an export completes with the duration of
+	// the export, including retries, and the error it failed with, if any.
+	// It needs to be safe to call concurrently.
+	ExportDone(ctx context.Context, duration time.Duration, err error)
+}
@@ -27,6 +27,7 @@ import (
 
 	"go.opentelemetry.io/otel/exporters/otlp/internal"
 	"go.opentelemetry.io/otel/exporters/otlp/internal/retry"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
 )
 
 const (
@@ -63,6 +64,10 @@ type (
 		ServiceConfig      string
 		DialOptions        []grpc.DialOption
 		GRPCConn           *grpc.ClientConn
+
+		// ExportObserver is notified of the exports made by the client.
+		// If nil, nothing is observed.
+		ExportObserver otlptrace.ExportObserver
 	}
 )
 
@@ -269,6 +274,13 @@ func WithRetry(rc retry.Config) GenericOption {
 	})
 }
 
+func WithExportObserver(o otlptrace.ExportObserver) GenericOption {
+	return newGenericOption(func(cfg Config) Config {
+		cfg.ExportObserver = o
+		return cfg
+	})
+}
+
 func WithTLSClientConfig(tlsCfg *tls.Config) GenericOption {
 	return newSplitOption(func(cfg Config) Config {
 		cfg.Traces.TLSCfg = tlsCfg.Clone()
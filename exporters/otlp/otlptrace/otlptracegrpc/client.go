@@ -56,6 +56,8 @@ type client struct {
 	conn    *grpc.ClientConn
 	tscMu   sync.RWMutex
 	tsc     coltracepb.TraceServiceClient
+
+	observer otlptrace.ExportObserver
 }
 
 // Compile time check *client implements otlptrace.Client.
@@ -79,6 +81,7 @@ func newClient(opts ...Option) *client {
 		stopCtx:       ctx,
 		stopFunc:      cancel,
 		conn:          cfg.GRPCConn,
+		observer:      cfg.ExportObserver,
 	}
 
 	if len(cfg.Traces.Headers) > 0 {
@@ -182,7 +185,13 @@ var errShutdown = errors.New("the client is shutdown")
 //
 // Retryable errors from the server will be handled according to any
 // RetryConfig the client was created with.
-func (c *client) UploadTraces(ctx context.Context, protoSpans []*tracepb.ResourceSpans) error {
+func (c *client) UploadTraces(ctx context.Context, protoSpans []*tracepb.ResourceSpans) (err error) {
+	if c.observer != nil {
+		defer func(ctx context.Context, start time.Time) {
+			c.observer.ExportDone(ctx, time.Since(start), err)
+		}(ctx, time.Now())
+	}
+
 	// Hold a read lock to ensure a shut down initiated after this starts does
 	// not abandon the export. This read lock acquire has less priority than a
 	// write lock acquire (i.e. Stop), meaning if the client is shutting down
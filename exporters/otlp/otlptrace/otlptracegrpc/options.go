@@ -23,6 +23,7 @@ import (
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/exporters/otlp/internal/retry"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/internal/otlpconfig"
 )
 
@@ -187,3 +188,15 @@ func WithTimeout(duration time.Duration) Option {
 func WithRetry(settings RetryConfig) Option {
 	return wrappedOption{otlpconfig.WithRetry(retry.Config(settings))}
 }
+
+// WithExportObserver sets the ExportObserver notified of the duration and
+// result of each export made by the client.
+//
+// If the observer reports its observations as telemetry, it should not be
+// exported with this client, otherwise the client reports on the exports of
+// its own reports.
+//
+// By default, if this option is not used, nothing is observed.
+func WithExportObserver(o otlptrace.ExportObserver) Option {
+	return wrappedOption{otlpconfig.WithExportObserver(o)}
+}
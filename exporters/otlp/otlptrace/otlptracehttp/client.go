@@ -72,6 +72,7 @@ type client struct {
 	client      *http.Client
 	stopCh      chan struct{}
 	stopOnce    sync.Once
+	observer    otlptrace.ExportObserver
 }
 
 var _ otlptrace.Client = (*client)(nil)
@@ -98,6 +99,7 @@ func NewClient(opts ...Option) otlptrace.Client {
 		requestFunc: cfg.RetryConfig.RequestFunc(evaluate),
 		stopCh:      stopCh,
 		client:      httpClient,
+		observer:    cfg.ExportObserver,
 	}
 }
 
@@ -126,7 +128,13 @@ func (d *client) Stop(ctx context.Context) error {
 }
 
 // UploadTraces sends a batch of spans to the collector.
-func (d *client) UploadTraces(ctx context.Context, protoSpans []*tracepb.ResourceSpans) error {
+func (d *client) UploadTraces(ctx context.Context, protoSpans []*tracepb.ResourceSpans) (err error) {
+	if d.observer != nil {
+		defer func(ctx context.Context, start time.Time) {
+			d.observer.ExportDone(ctx, time.Since(start), err)
+		}(ctx, time.Now())
+	}
+
 	pbRequest := &coltracepb.ExportTraceServiceRequest{
 		ResourceSpans: protoSpans,
 	}
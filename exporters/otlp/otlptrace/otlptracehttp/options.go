@@ -19,6 +19,7 @@ import (
 	"time"
 
 	"go.opentelemetry.io/otel/exporters/otlp/internal/retry"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/internal/otlpconfig"
 )
 
@@ -114,3 +115,15 @@ func WithTimeout(duration time.Duration) Option {
 func WithRetry(rc RetryConfig) Option {
 	return wrappedOption{otlpconfig.WithRetry(retry.Config(rc))}
 }
+
+// WithExportObserver sets the ExportObserver notified of the duration and
+// result of each export made by the client.
+//
+// If the observer reports its observations as telemetry, it should not be
+// exported with this client, otherwise the client reports on the exports of
+// its own reports.
+//
+// By default, if this option is not used, nothing is observed.
+func WithExportObserver(o otlptrace.ExportObserver) Option {
+	return wrappedOption{otlpconfig.WithExportObserver(o)}
+}
@@ -27,6 +27,7 @@ type config struct {
 	withoutUnits      bool
 	aggregation       metric.AggregationSelector
 	disableScopeInfo  bool
+	producers         []metric.Producer
 }
 
 // newConfig creates a validated config configured with options.
@@ -48,6 +49,9 @@ func (cfg config) manualReaderOptions() []metric.ManualReaderOption {
 	if cfg.aggregation != nil {
 		opts = append(opts, metric.WithAggregationSelector(cfg.aggregation))
 	}
+	for _, p := range cfg.producers {
+		opts = append(opts, metric.WithProducer(p))
+	}
 	return opts
 }
 
@@ -116,3 +120,15 @@ func WithoutScopeInfo() Option {
 		return cfg
 	})
 }
+
+// WithProducer configures the Exporter to also export the metric data
+// produced by p. This can be used to export metric data from bridges, such as
+// the OpenCensus bridge, alongside the metric data of the OpenTelemetry SDK.
+//
+// This option can be used multiple times to register multiple Producers.
+func WithProducer(p metric.Producer) Option {
+	return optionFunc(func(cfg config) config {
+		cfg.producers = append(cfg.producers, p)
+		return cfg
+	})
+}
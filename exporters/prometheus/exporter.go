@@ -16,6 +16,7 @@ package prometheus // import "go.opentelemetry.io/otel/exporters/prometheus"
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
 	"sort"
 	"strings"
@@ -40,6 +41,9 @@ const (
 
 	scopeInfoMetricName  = "otel_scope_info"
 	scopeInfoDescription = "Instrumentation Scope metadata"
+
+	traceIDExemplarKey = "trace_id"
+	spanIDExemplarKey  = "span_id"
 )
 
 var scopeInfoKeys = [2]string{"otel_scope_name", "otel_scope_version"}
@@ -158,13 +162,14 @@ func (c *collector) Collect(ch chan<- prometheus.Metric) {
 				addGaugeMetric(ch, v, m, keys, values, c.getName(m))
 			case metricdata.Gauge[float64]:
 				addGaugeMetric(ch, v, m, keys, values, c.getName(m))
+			case metricdata.Summary:
+				addSummaryMetric(ch, v, m, keys, values, c.getName(m))
 			}
 		}
 	}
 }
 
 func addHistogramMetric(ch chan<- prometheus.Metric, histogram metricdata.Histogram, m metricdata.Metrics, ks, vs [2]string, name string) {
-	// TODO(https://github.com/open-telemetry/opentelemetry-go/issues/3163): support exemplars
 	for _, dp := range histogram.DataPoints {
 		keys, values := getAttrs(dp.Attributes, ks, vs)
 
@@ -181,7 +186,25 @@ func addHistogramMetric(ch chan<- prometheus.Metric, histogram metricdata.Histog
 			otel.Handle(err)
 			continue
 		}
-		ch <- m
+		ch <- addExemplars(m, dp.Exemplars)
+	}
+}
+
+func addSummaryMetric(ch chan<- prometheus.Metric, summary metricdata.Summary, m metricdata.Metrics, ks, vs [2]string, name string) {
+	for _, dp := range summary.DataPoints {
+		keys, values := getAttrs(dp.Attributes, ks, vs)
+
+		desc := prometheus.NewDesc(name, m.Description, keys, nil)
+		quantiles := make(map[float64]float64, len(dp.QuantileValues))
+		for _, q := range dp.QuantileValues {
+			quantiles[q.Quantile] = q.Value
+		}
+		s, err := prometheus.NewConstSummary(desc, dp.Count, dp.Sum, quantiles, values...)
+		if err != nil {
+			otel.Handle(err)
+			continue
+		}
+		ch <- s
 	}
 }
 
@@ -203,6 +226,10 @@ func addSumMetric[N int64 | float64](ch chan<- prometheus.Metric, sum metricdata
 			otel.Handle(err)
 			continue
 		}
+		// OpenMetrics only supports exemplars for counters.
+		if sum.IsMonotonic {
+			m = addExemplars(m, dp.Exemplars)
+		}
 		ch <- m
 	}
 }
@@ -221,6 +248,44 @@ func addGaugeMetric[N int64 | float64](ch chan<- prometheus.Metric, gauge metric
 	}
 }
 
+// addExemplars returns m with exemplars attached. If exemplars is empty or
+// they cannot be attached, the error is handled and m is returned unchanged.
+func addExemplars[N int64 | float64](m prometheus.Metric, exemplars []metricdata.Exemplar[N]) prometheus.Metric {
+	if len(exemplars) == 0 {
+		return m
+	}
+	promExemplars := make([]prometheus.Exemplar, 0, len(exemplars))
+	for _, e := range exemplars {
+		promExemplars = append(promExemplars, prometheus.Exemplar{
+			Value:     float64(e.Value),
+			Labels:    exemplarLabels(e),
+			Timestamp: e.Time,
+		})
+	}
+	withExemplars, err := prometheus.NewMetricWithExemplars(m, promExemplars...)
+	if err != nil {
+		otel.Handle(err)
+		return m
+	}
+	return withExemplars
+}
+
+// exemplarLabels returns the Prometheus labels of e. The trace and span IDs
+// of e are included as the "trace_id" and "span_id" labels if they are set.
+func exemplarLabels[N int64 | float64](e metricdata.Exemplar[N]) prometheus.Labels {
+	labels := make(prometheus.Labels, len(e.FilteredAttributes)+2)
+	for _, kv := range e.FilteredAttributes {
+		labels[strings.Map(sanitizeRune, string(kv.Key))] = kv.Value.Emit()
+	}
+	if len(e.TraceID) > 0 {
+		labels[traceIDExemplarKey] = hex.EncodeToString(e.TraceID)
+	}
+	if len(e.SpanID) > 0 {
+		labels[spanIDExemplarKey] = hex.EncodeToString(e.SpanID)
+	}
+	return labels
+}
+
 // getAttrs parses the attribute.Set to two lists of matching Prometheus-style
 // keys and values. It sanitizes invalid characters and handles duplicate keys
 // (due to sanitization) by sorting and concatenating the values following the spec.
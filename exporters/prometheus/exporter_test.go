@@ -21,6 +21,7 @@ import (
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -28,11 +29,15 @@ import (
 	otelmetric "go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/metric/instrument"
 	"go.opentelemetry.io/otel/metric/unit"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
 	"go.opentelemetry.io/otel/sdk/metric"
 	"go.opentelemetry.io/otel/sdk/metric/aggregation"
+	"go.opentelemetry.io/otel/sdk/metric/exemplar"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 	"go.opentelemetry.io/otel/sdk/metric/view"
 	"go.opentelemetry.io/otel/sdk/resource"
 	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
+	"go.opentelemetry.io/otel/trace"
 )
 
 func TestPrometheusExporter(t *testing.T) {
@@ -260,6 +265,12 @@ func TestPrometheusExporter(t *testing.T) {
 				counter.Add(ctx, 1, attrs...)
 			},
 		},
+		{
+			name:          "summary from producer",
+			options:       []Option{WithoutScopeInfo(), WithoutTargetInfo(), WithProducer(summaryProducer{})},
+			expectedFile:  "testdata/summary.txt",
+			recordMetrics: func(context.Context, otelmetric.Meter) {},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -319,6 +330,32 @@ func TestPrometheusExporter(t *testing.T) {
 	}
 }
 
+// summaryProducer produces a Summary the way bridges from other
+// instrumentation libraries do.
+type summaryProducer struct{}
+
+func (summaryProducer) Produce(context.Context) ([]metricdata.ScopeMetrics, error) {
+	return []metricdata.ScopeMetrics{{
+		Scope: instrumentation.Scope{Name: "summaryProducer"},
+		Metrics: []metricdata.Metrics{{
+			Name:        "latency",
+			Description: "a fun little summary",
+			Unit:        unit.Milliseconds,
+			Data: metricdata.Summary{
+				DataPoints: []metricdata.SummaryDataPoint{{
+					Attributes: attribute.NewSet(attribute.String("A", "B")),
+					Count:      10,
+					Sum:        55,
+					QuantileValues: []metricdata.QuantileValue{
+						{Quantile: 0.5, Value: 5},
+						{Quantile: 0.99, Value: 10},
+					},
+				}},
+			},
+		}},
+	}}, nil
+}
+
 func TestSantitizeName(t *testing.T) {
 	tests := []struct {
 		input string
@@ -393,3 +430,78 @@ func TestMultiScopes(t *testing.T) {
 	err = testutil.GatherAndCompare(registry, file)
 	require.NoError(t, err)
 }
+
+func TestExemplars(t *testing.T) {
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{0x01},
+		SpanID:     trace.SpanID{0x01},
+		TraceFlags: trace.FlagsSampled,
+	})
+	ctx := trace.ContextWithSpanContext(context.Background(), sc)
+
+	registry := prometheus.NewRegistry()
+	exporter, err := New(WithRegisterer(registry), WithoutTargetInfo(), WithoutScopeInfo())
+	require.NoError(t, err)
+
+	histView, err := view.New(
+		view.MatchInstrumentName("histogram"),
+		view.WithSetAggregation(aggregation.ExplicitBucketHistogram{
+			Boundaries: []float64{0, 5, 10},
+		}),
+	)
+	require.NoError(t, err)
+	defaultView, err := view.New(view.MatchInstrumentName("*"))
+	require.NoError(t, err)
+
+	provider := metric.NewMeterProvider(
+		metric.WithReader(exporter),
+		metric.WithView(histView, defaultView),
+		metric.WithExemplarFilter(exemplar.TraceBasedFilter),
+	)
+	meter := provider.Meter("testmeter")
+
+	counter, err := meter.SyncInt64().Counter("counter")
+	require.NoError(t, err)
+	counter.Add(ctx, 5)
+
+	histogram, err := meter.SyncFloat64().Histogram("histogram")
+	require.NoError(t, err)
+	histogram.Record(ctx, 7)
+
+	wantLabels := map[string]string{
+		"span_id":  "0100000000000000",
+		"trace_id": "01000000000000000000000000000000",
+	}
+	labels := func(ex *dto.Exemplar) map[string]string {
+		out := make(map[string]string)
+		for _, l := range ex.GetLabel() {
+			out[l.GetName()] = l.GetValue()
+		}
+		return out
+	}
+
+	families, err := registry.Gather()
+	require.NoError(t, err)
+	require.Len(t, families, 2)
+	for _, mf := range families {
+		require.Len(t, mf.Metric, 1)
+		switch mf.GetName() {
+		case "counter_total":
+			ex := mf.Metric[0].GetCounter().GetExemplar()
+			require.NotNil(t, ex)
+			assert.Equal(t, 5.0, ex.GetValue())
+			assert.Equal(t, wantLabels, labels(ex))
+		case "histogram":
+			buckets := mf.Metric[0].GetHistogram().GetBucket()
+			require.Len(t, buckets, 3)
+			assert.Nil(t, buckets[0].GetExemplar())
+			assert.Nil(t, buckets[1].GetExemplar())
+			ex := buckets[2].GetExemplar()
+			require.NotNil(t, ex)
+			assert.Equal(t, 7.0, ex.GetValue())
+			assert.Equal(t, wantLabels, labels(ex))
+		default:
+			t.Errorf("unexpected metric family: %s", mf.GetName())
+		}
+	}
+}
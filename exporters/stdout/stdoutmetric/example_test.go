@@ -80,6 +80,29 @@ var (
 							},
 						},
 					},
+					{
+						Name:        "latency.exponential",
+						Description: "Time spend processing received requests",
+						Unit:        unit.Milliseconds,
+						Data: metricdata.ExponentialHistogram{
+							Temporality: metricdata.DeltaTemporality,
+							DataPoints: []metricdata.ExponentialHistogramDataPoint{
+								{
+									Attributes: attribute.NewSet(attribute.String("server", "central")),
+									StartTime:  now,
+									Time:       now.Add(1 * time.Second),
+									Count:      10,
+									Sum:        57,
+									Scale:      1,
+									ZeroCount:  1,
+									PositiveBucket: metricdata.ExponentialBucket{
+										Offset: 2,
+										Counts: []uint64{1, 3, 5},
+									},
+								},
+							},
+						},
+					},
 					{
 						Name:        "temperature",
 						Description: "CPU global temperature",
@@ -123,4 +146,163 @@ func Example() {
 
 	// Ensure the periodic reader is cleaned up by shutting down the sdk.
 	_ = sdk.Shutdown(ctx)
+
+	// Output:
+	// {
+	//   "Resource": [
+	//     {
+	//       "Key": "service.name",
+	//       "Value": {
+	//         "Type": "STRING",
+	//         "Value": "stdoutmetric-example"
+	//       }
+	//     }
+	//   ],
+	//   "ScopeMetrics": [
+	//     {
+	//       "Scope": {
+	//         "Name": "example",
+	//         "Version": "v0.0.1",
+	//         "SchemaURL": ""
+	//       },
+	//       "Metrics": [
+	//         {
+	//           "Name": "requests",
+	//           "Description": "Number of requests received",
+	//           "Unit": "1",
+	//           "Data": {
+	//             "DataPoints": [
+	//               {
+	//                 "Attributes": [
+	//                   {
+	//                     "Key": "server",
+	//                     "Value": {
+	//                       "Type": "STRING",
+	//                       "Value": "central"
+	//                     }
+	//                   }
+	//                 ],
+	//                 "StartTime": "2000-01-01T00:00:00Z",
+	//                 "Time": "2000-01-01T00:00:01Z",
+	//                 "Value": 5
+	//               }
+	//             ],
+	//             "Temporality": "DeltaTemporality",
+	//             "IsMonotonic": true
+	//           }
+	//         },
+	//         {
+	//           "Name": "latency",
+	//           "Description": "Time spend processing received requests",
+	//           "Unit": "ms",
+	//           "Data": {
+	//             "DataPoints": [
+	//               {
+	//                 "Attributes": [
+	//                   {
+	//                     "Key": "server",
+	//                     "Value": {
+	//                       "Type": "STRING",
+	//                       "Value": "central"
+	//                     }
+	//                   }
+	//                 ],
+	//                 "StartTime": "2000-01-01T00:00:00Z",
+	//                 "Time": "2000-01-01T00:00:01Z",
+	//                 "Count": 10,
+	//                 "Bounds": [
+	//                   1,
+	//                   5,
+	//                   10
+	//                 ],
+	//                 "BucketCounts": [
+	//                   1,
+	//                   3,
+	//                   6,
+	//                   0
+	//                 ],
+	//                 "Sum": 57
+	//               }
+	//             ],
+	//             "Temporality": "DeltaTemporality"
+	//           }
+	//         },
+	//         {
+	//           "Name": "latency.exponential",
+	//           "Description": "Time spend processing received requests",
+	//           "Unit": "ms",
+	//           "Data": {
+	//             "DataPoints": [
+	//               {
+	//                 "Attributes": [
+	//                   {
+	//                     "Key": "server",
+	//                     "Value": {
+	//                       "Type": "STRING",
+	//                       "Value": "central"
+	//                     }
+	//                   }
+	//                 ],
+	//                 "StartTime": "2000-01-01T00:00:00Z",
+	//                 "Time": "2000-01-01T00:00:01Z",
+	//                 "Count": 10,
+	//                 "Sum": 57,
+	//                 "Scale": 1,
+	//                 "ZeroCount": 1,
+	//                 "PositiveBucket": {
+	//                   "Offset": 2,
+	//                   "Counts": [
+	//                     1,
+	//                     3,
+	//                     5
+	//                   ]
+	//                 },
+	//                 "NegativeBucket": {
+	//                   "Offset": 0,
+	//                   "Counts": null
+	//                 }
+	//               }
+	//             ],
+	//             "Temporality": "DeltaTemporality"
+	//           }
+	//         },
+	//         {
+	//           "Name": "temperature",
+	//           "Description": "CPU global temperature",
+	//           "Unit": "cel(1 K)",
+	//           "Data": {
+	//             "DataPoints": [
+	//               {
+	//                 "Attributes": [
+	//                   {
+	//                     "Key": "server",
+	//                     "Value": {
+	//                       "Type": "STRING",
+	//                       "Value": "central"
+	//                     }
+	//                   }
+	//                 ],
+	//                 "StartTime": "0001-01-01T00:00:00Z",
+	//                 "Time": "2000-01-01T00:00:01Z",
+	//                 "Value": 32.4
+	//               }
+	//             ]
+	//           }
+	//         }
+	//       ]
+	//     }
+	//   ]
+	// }
+	// {
+	//   "Resource": [
+	//     {
+	//       "Key": "service.name",
+	//       "Value": {
+	//         "Type": "STRING",
+	//         "Value": "stdoutmetric-example"
+	//       }
+	//     }
+	//   ],
+	//   "ScopeMetrics": []
+	// }
 }
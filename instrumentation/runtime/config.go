@@ -0,0 +1,83 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime // import "go.opentelemetry.io/otel/instrumentation/runtime"
+
+import (
+	"time"
+
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/global"
+)
+
+// DefaultMinimumReadInterval is the default minimum interval between reads of
+// the runtime metrics.
+const DefaultMinimumReadInterval time.Duration = 15 * time.Second
+
+// config contains options for the runtime instrumentation.
+type config struct {
+	meterProvider       metric.MeterProvider
+	minimumReadInterval time.Duration
+}
+
+// newConfig creates a validated config configured with options.
+func newConfig(opts ...Option) config {
+	cfg := config{minimumReadInterval: DefaultMinimumReadInterval}
+	for _, opt := range opts {
+		cfg = opt.apply(cfg)
+	}
+
+	if cfg.meterProvider == nil {
+		cfg.meterProvider = global.MeterProvider()
+	}
+	if cfg.minimumReadInterval < 0 {
+		cfg.minimumReadInterval = DefaultMinimumReadInterval
+	}
+
+	return cfg
+}
+
+// Option sets runtime instrumentation option values.
+type Option interface {
+	apply(config) config
+}
+
+type optionFunc func(config) config
+
+func (fn optionFunc) apply(cfg config) config {
+	return fn(cfg)
+}
+
+// WithMeterProvider sets the MeterProvider the runtime instruments are
+// created with. If this option is not provided, the global MeterProvider is
+// used.
+func WithMeterProvider(mp metric.MeterProvider) Option {
+	return optionFunc(func(cfg config) config {
+		cfg.meterProvider = mp
+		return cfg
+	})
+}
+
+// WithMinimumReadInterval sets the minimum interval between reads of the
+// runtime metrics. Collections made more frequently than this interval report
+// the values of the previous read. A zero interval reads the runtime metrics
+// for every collection. If d is negative, DefaultMinimumReadInterval is used.
+//
+// If this option is not provided, DefaultMinimumReadInterval is used.
+func WithMinimumReadInterval(d time.Duration) Option {
+	return optionFunc(func(cfg config) config {
+		cfg.minimumReadInterval = d
+		return cfg
+	})
+}
@@ -0,0 +1,37 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package runtime provides instrumentation for the Go runtime.
+//
+// The Start function registers instruments that report the metrics of the
+// Go runtime, read using the runtime/metrics package, with a MeterProvider.
+// All instruments are named with the "process.runtime.go." prefix.
+//
+//	process.runtime.go.cgo.calls         - Number of cgo calls made by the current process
+//	process.runtime.go.gc.count          - Number of completed garbage collection cycles
+//	process.runtime.go.gc.heap_goal      - Heap size target for the end of the GC cycle
+//	process.runtime.go.gc.pause_ns       - Amount of nanoseconds in GC stop-the-world pauses
+//	process.runtime.go.goroutines        - Number of goroutines that currently exist
+//	process.runtime.go.mem.heap_alloc    - Bytes of allocated heap objects
+//	process.runtime.go.mem.heap_idle     - Bytes in idle (unused) spans
+//	process.runtime.go.mem.heap_inuse    - Bytes in in-use spans
+//	process.runtime.go.mem.heap_objects  - Number of allocated heap objects
+//	process.runtime.go.mem.heap_released - Bytes of idle spans whose physical memory has been returned to the OS
+//	process.runtime.go.mem.heap_sys      - Bytes of heap memory obtained from the OS
+//
+// The process.runtime.go.gc.pause_ns instrument is a histogram. Each GC pause
+// that occurred since the previous read of the runtime metrics is recorded
+// with it. All other instruments are asynchronous and are observed when the
+// MeterProvider collects.
+package runtime // import "go.opentelemetry.io/otel/instrumentation/runtime"
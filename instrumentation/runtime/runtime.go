@@ -0,0 +1,367 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime // import "go.opentelemetry.io/otel/instrumentation/runtime"
+
+import (
+	"context"
+	"math"
+	"runtime/metrics"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/instrument"
+	"go.opentelemetry.io/otel/metric/instrument/syncint64"
+	"go.opentelemetry.io/otel/metric/unit"
+)
+
+// ScopeName is the instrumentation scope name of the Meter the runtime
+// instruments are created with.
+const ScopeName = "go.opentelemetry.io/otel/instrumentation/runtime"
+
+// Start registers the Go runtime instruments with the MeterProvider
+// configured by opts.
+//
+// Instruments for runtime metrics not supported by the running version of Go
+// are not registered.
+func Start(opts ...Option) error {
+	cfg := newConfig(opts...)
+	r := newReader(cfg.meterProvider.Meter(ScopeName), cfg.minimumReadInterval)
+	return r.register()
+}
+
+// int64Metric describes an asynchronous int64 instrument whose value is the
+// sum of the runtime/metrics samples identified by keys.
+type int64Metric struct {
+	name        string
+	description string
+	unit        unit.Unit
+	// monotonic instruments are created as counters, all others are created
+	// as up-down counters.
+	monotonic bool
+	keys      []string
+}
+
+const (
+	keyHeapFree     = "/memory/classes/heap/free:bytes"
+	keyHeapObjects  = "/memory/classes/heap/objects:bytes"
+	keyHeapReleased = "/memory/classes/heap/released:bytes"
+	keyHeapUnused   = "/memory/classes/heap/unused:bytes"
+)
+
+var int64Metrics = []int64Metric{
+	{
+		name:        "process.runtime.go.cgo.calls",
+		description: "Number of cgo calls made by the current process",
+		unit:        unit.Dimensionless,
+		monotonic:   true,
+		keys:        []string{"/cgo/go-to-c-calls:calls"},
+	},
+	{
+		name:        "process.runtime.go.gc.count",
+		description: "Number of completed garbage collection cycles",
+		unit:        unit.Dimensionless,
+		monotonic:   true,
+		keys:        []string{"/gc/cycles/total:gc-cycles"},
+	},
+	{
+		name:        "process.runtime.go.gc.heap_goal",
+		description: "Heap size target for the end of the GC cycle",
+		unit:        unit.Bytes,
+		keys:        []string{"/gc/heap/goal:bytes"},
+	},
+	{
+		name:        "process.runtime.go.goroutines",
+		description: "Number of goroutines that currently exist",
+		unit:        unit.Dimensionless,
+		keys:        []string{"/sched/goroutines:goroutines"},
+	},
+	{
+		name:        "process.runtime.go.mem.heap_alloc",
+		description: "Bytes of allocated heap objects",
+		unit:        unit.Bytes,
+		keys:        []string{keyHeapObjects},
+	},
+	{
+		name:        "process.runtime.go.mem.heap_idle",
+		description: "Bytes in idle (unused) spans",
+		unit:        unit.Bytes,
+		keys:        []string{keyHeapFree, keyHeapReleased},
+	},
+	{
+		name:        "process.runtime.go.mem.heap_inuse",
+		description: "Bytes in in-use spans",
+		unit:        unit.Bytes,
+		keys:        []string{keyHeapObjects, keyHeapUnused},
+	},
+	{
+		name:        "process.runtime.go.mem.heap_objects",
+		description: "Number of allocated heap objects",
+		unit:        unit.Dimensionless,
+		keys:        []string{"/gc/heap/objects:objects"},
+	},
+	{
+		name:        "process.runtime.go.mem.heap_released",
+		description: "Bytes of idle spans whose physical memory has been returned to the OS",
+		unit:        unit.Bytes,
+		keys:        []string{keyHeapReleased},
+	},
+	{
+		name:        "process.runtime.go.mem.heap_sys",
+		description: "Bytes of heap memory obtained from the OS",
+		unit:        unit.Bytes,
+		keys:        []string{keyHeapObjects, keyHeapUnused, keyHeapFree, keyHeapReleased},
+	},
+}
+
+// gcPauseKeys are the keys of the runtime/metrics GC pause distribution in
+// order of preference. The first key supported by the running version of Go
+// is used.
+var gcPauseKeys = []string{
+	"/sched/pauses/total/gc:seconds",
+	"/gc/pauses:seconds",
+}
+
+// maxGCPauses is the maximum number of GC pauses recorded per read of the
+// runtime metrics. It bounds the work done in a collection when many GCs ran
+// since the previous one.
+const maxGCPauses = 1024
+
+// nanoseconds is the unit of the GC pause durations. It is not defined by the
+// unit package.
+const nanoseconds unit.Unit = "ns"
+
+// reader reads the runtime metrics and reports them with instruments from
+// meter.
+type reader struct {
+	meter    metric.Meter
+	interval time.Duration
+
+	// read and now are replaced in testing.
+	read func([]metrics.Sample)
+	now  func() time.Time
+
+	mu       sync.Mutex
+	lastRead time.Time
+	samples  []metrics.Sample
+	index    map[string]int
+
+	gcPause    syncint64.Histogram
+	gcPauseKey string
+	// gcPauseCounts are the GC pause bucket counts of the previous read.
+	gcPauseCounts []uint64
+}
+
+func newReader(meter metric.Meter, interval time.Duration) *reader {
+	return &reader{
+		meter:    meter,
+		interval: interval,
+		read:     metrics.Read,
+		now:      time.Now,
+		index:    make(map[string]int),
+	}
+}
+
+// supported returns the keys of the runtime metrics supported by the running
+// version of Go mapped to the kind of their values.
+func supported() map[string]metrics.ValueKind {
+	all := metrics.All()
+	kinds := make(map[string]metrics.ValueKind, len(all))
+	for _, d := range all {
+		kinds[d.Name] = d.Kind
+	}
+	return kinds
+}
+
+// addSample adds a sample for key to the samples r reads.
+func (r *reader) addSample(key string) {
+	if _, ok := r.index[key]; ok {
+		return
+	}
+	r.index[key] = len(r.samples)
+	r.samples = append(r.samples, metrics.Sample{Name: key})
+}
+
+// register creates the runtime instruments and registers the callback that
+// observes them.
+func (r *reader) register() error {
+	kinds := supported()
+
+	var (
+		insts   []instrument.Asynchronous
+		observe []func(metric.Observer)
+	)
+	for _, m := range int64Metrics {
+		if !allOfKind(kinds, m.keys, metrics.KindUint64) {
+			continue
+		}
+
+		opts := []instrument.Option{
+			instrument.WithDescription(m.description),
+			instrument.WithUnit(m.unit),
+		}
+		var (
+			inst instrument.Asynchronous
+			err  error
+		)
+		if m.monotonic {
+			inst, err = r.meter.AsyncInt64().Counter(m.name, opts...)
+		} else {
+			inst, err = r.meter.AsyncInt64().UpDownCounter(m.name, opts...)
+		}
+		if err != nil {
+			return err
+		}
+
+		for _, key := range m.keys {
+			r.addSample(key)
+		}
+		insts = append(insts, inst)
+		keys := m.keys
+		observe = append(observe, func(o metric.Observer) {
+			o.ObserveInt64(inst, r.sum(keys))
+		})
+	}
+
+	for _, key := range gcPauseKeys {
+		if kinds[key] != metrics.KindFloat64Histogram {
+			continue
+		}
+		hist, err := r.meter.SyncInt64().Histogram(
+			"process.runtime.go.gc.pause_ns",
+			instrument.WithDescription("Amount of nanoseconds in GC stop-the-world pauses"),
+			instrument.WithUnit(nanoseconds),
+		)
+		if err != nil {
+			return err
+		}
+		r.gcPause, r.gcPauseKey = hist, key
+		r.addSample(key)
+		break
+	}
+
+	_, err := r.meter.RegisterCallback(insts, func(ctx context.Context, o metric.Observer) {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+
+		if r.refresh() && r.gcPause != nil {
+			v := r.samples[r.index[r.gcPauseKey]].Value
+			if v.Kind() == metrics.KindFloat64Histogram {
+				r.recordGCPauses(ctx, v.Float64Histogram())
+			}
+		}
+		for _, f := range observe {
+			f(o)
+		}
+	})
+	return err
+}
+
+// allOfKind returns if all keys are supported and of kind.
+func allOfKind(kinds map[string]metrics.ValueKind, keys []string, kind metrics.ValueKind) bool {
+	for _, key := range keys {
+		if kinds[key] != kind {
+			return false
+		}
+	}
+	return true
+}
+
+// refresh reads the runtime metrics if the minimum read interval has passed
+// since the last read. It returns if the runtime metrics were read.
+//
+// The caller must hold r.mu.
+func (r *reader) refresh() bool {
+	now := r.now()
+	if !r.lastRead.IsZero() && now.Sub(r.lastRead) < r.interval {
+		return false
+	}
+	r.read(r.samples)
+	r.lastRead = now
+	return true
+}
+
+// sum returns the sum of the last read uint64 values of the samples for keys.
+//
+// The caller must hold r.mu.
+func (r *reader) sum(keys []string) int64 {
+	var total uint64
+	for _, key := range keys {
+		v := r.samples[r.index[key]].Value
+		if v.Kind() == metrics.KindUint64 {
+			total += v.Uint64()
+		}
+	}
+	if total > math.MaxInt64 {
+		return math.MaxInt64
+	}
+	return int64(total)
+}
+
+// recordGCPauses records the GC pauses in h that were not in the previous
+// distribution passed. The first distribution is only used as the baseline,
+// pauses that happened before it are not recorded.
+//
+// At most maxGCPauses pauses are recorded per call. If more pauses happened,
+// the number recorded for each bucket is scaled down proportionally.
+//
+// The caller must hold r.mu.
+func (r *reader) recordGCPauses(ctx context.Context, h *metrics.Float64Histogram) {
+	if len(r.gcPauseCounts) != len(h.Counts) {
+		// First distribution, or the buckets changed. Use it as the baseline.
+		r.gcPauseCounts = make([]uint64, len(h.Counts))
+		copy(r.gcPauseCounts, h.Counts)
+		return
+	}
+
+	incr := make([]uint64, len(h.Counts))
+	var total uint64
+	for i, count := range h.Counts {
+		n := count - r.gcPauseCounts[i]
+		if count < r.gcPauseCounts[i] {
+			n = count
+		}
+		r.gcPauseCounts[i] = count
+		incr[i] = n
+		total += n
+	}
+
+	for i, n := range incr {
+		if total > maxGCPauses {
+			n = uint64(float64(n) * maxGCPauses / float64(total))
+		}
+		if n == 0 {
+			continue
+		}
+
+		ns := int64(bucketValue(h.Buckets[i], h.Buckets[i+1]) * 1e9)
+		for j := uint64(0); j < n; j++ {
+			r.gcPause.Record(ctx, ns)
+		}
+	}
+}
+
+// bucketValue returns the value used to represent measurements in the bucket
+// with the lower bound lo and upper bound hi. This is the midpoint of the
+// bucket, or its finite bound if the bucket is unbounded.
+func bucketValue(lo, hi float64) float64 {
+	switch {
+	case math.IsInf(lo, -1):
+		return hi
+	case math.IsInf(hi, 1):
+		return lo
+	}
+	return lo + (hi-lo)/2
+}
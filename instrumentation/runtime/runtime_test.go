@@ -0,0 +1,200 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime // import "go.opentelemetry.io/otel/instrumentation/runtime"
+
+import (
+	"context"
+	"math"
+	goruntime "runtime"
+	"runtime/metrics"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func collect(t *testing.T, r sdkmetric.Reader) map[string]metricdata.Aggregation {
+	t.Helper()
+
+	rm, err := r.Collect(context.Background())
+	require.NoError(t, err)
+	require.Len(t, rm.ScopeMetrics, 1)
+	assert.Equal(t, ScopeName, rm.ScopeMetrics[0].Scope.Name)
+
+	got := make(map[string]metricdata.Aggregation)
+	for _, m := range rm.ScopeMetrics[0].Metrics {
+		got[m.Name] = m.Data
+	}
+	return got
+}
+
+func int64Value(t *testing.T, data metricdata.Aggregation) int64 {
+	t.Helper()
+
+	sum, ok := data.(metricdata.Sum[int64])
+	require.Truef(t, ok, "unexpected aggregation: %T", data)
+	require.Len(t, sum.DataPoints, 1)
+	return sum.DataPoints[0].Value
+}
+
+func TestStart(t *testing.T) {
+	rdr := sdkmetric.NewManualReader()
+	mp := sdkmetric.NewMeterProvider(sdkmetric.WithReader(rdr))
+	require.NoError(t, Start(WithMeterProvider(mp), WithMinimumReadInterval(0)))
+
+	goruntime.GC()
+	got := collect(t, rdr)
+
+	for _, m := range int64Metrics {
+		require.Contains(t, got, m.name)
+		sum, ok := got[m.name].(metricdata.Sum[int64])
+		require.Truef(t, ok, "%s: unexpected aggregation: %T", m.name, got[m.name])
+		assert.Equal(t, m.monotonic, sum.IsMonotonic, m.name)
+	}
+	assert.Greater(t, int64Value(t, got["process.runtime.go.goroutines"]), int64(0))
+	assert.Greater(t, int64Value(t, got["process.runtime.go.gc.count"]), int64(0))
+
+	// Pauses before the first collect are not recorded.
+	assert.NotContains(t, got, "process.runtime.go.gc.pause_ns")
+
+	goruntime.GC()
+	got = collect(t, rdr)
+	require.Contains(t, got, "process.runtime.go.gc.pause_ns")
+	hist, ok := got["process.runtime.go.gc.pause_ns"].(metricdata.Histogram)
+	require.Truef(t, ok, "unexpected aggregation: %T", got["process.runtime.go.gc.pause_ns"])
+	require.Len(t, hist.DataPoints, 1)
+	pauses := hist.DataPoints[0].Count
+	assert.Greater(t, pauses, uint64(0))
+
+	// Pauses are only recorded once.
+	got = collect(t, rdr)
+	hist = got["process.runtime.go.gc.pause_ns"].(metricdata.Histogram)
+	assert.Equal(t, pauses, hist.DataPoints[0].Count)
+
+	goruntime.GC()
+	got = collect(t, rdr)
+	hist = got["process.runtime.go.gc.pause_ns"].(metricdata.Histogram)
+	assert.Greater(t, hist.DataPoints[0].Count, pauses)
+}
+
+func TestGCPauseUnit(t *testing.T) {
+	rdr := sdkmetric.NewManualReader()
+	mp := sdkmetric.NewMeterProvider(sdkmetric.WithReader(rdr))
+	require.NoError(t, Start(WithMeterProvider(mp), WithMinimumReadInterval(0)))
+
+	_ = collect(t, rdr)
+	goruntime.GC()
+	rm, err := rdr.Collect(context.Background())
+	require.NoError(t, err)
+	require.Len(t, rm.ScopeMetrics, 1)
+	for _, m := range rm.ScopeMetrics[0].Metrics {
+		if m.Name == "process.runtime.go.gc.pause_ns" {
+			assert.Equal(t, nanoseconds, m.Unit)
+			return
+		}
+	}
+	t.Fatal("process.runtime.go.gc.pause_ns not collected")
+}
+
+func TestRecordGCPauses(t *testing.T) {
+	rdr := sdkmetric.NewManualReader()
+	mp := sdkmetric.NewMeterProvider(sdkmetric.WithReader(rdr))
+	hist, err := mp.Meter(ScopeName).SyncInt64().Histogram("pauses")
+	require.NoError(t, err)
+
+	r := newReader(mp.Meter(ScopeName), 0)
+	r.gcPause = hist
+
+	count := func() uint64 {
+		rm, err := rdr.Collect(context.Background())
+		require.NoError(t, err)
+		require.Len(t, rm.ScopeMetrics, 1)
+		require.Len(t, rm.ScopeMetrics[0].Metrics, 1)
+		h := rm.ScopeMetrics[0].Metrics[0].Data.(metricdata.Histogram)
+		require.Len(t, h.DataPoints, 1)
+		return h.DataPoints[0].Count
+	}
+
+	ctx := context.Background()
+	h := &metrics.Float64Histogram{
+		Counts:  []uint64{1 << 40, 1 << 40},
+		Buckets: []float64{0, 1e-6, 1e-3},
+	}
+	r.recordGCPauses(ctx, h)
+	rm, err := rdr.Collect(ctx)
+	require.NoError(t, err)
+	assert.Len(t, rm.ScopeMetrics, 0, "pauses before the baseline recorded")
+
+	h.Counts = []uint64{1<<40 + 2, 1<<40 + 1}
+	r.recordGCPauses(ctx, h)
+	assert.Equal(t, uint64(3), count(), "increment not recorded")
+
+	h.Counts = []uint64{1<<40 + 2 + 3*maxGCPauses, 1<<40 + 1 + maxGCPauses}
+	r.recordGCPauses(ctx, h)
+	assert.Equal(t, uint64(3+maxGCPauses), count(), "pauses not bounded")
+}
+
+func TestMinimumReadInterval(t *testing.T) {
+	rdr := sdkmetric.NewManualReader()
+	mp := sdkmetric.NewMeterProvider(sdkmetric.WithReader(rdr))
+
+	r := newReader(mp.Meter(ScopeName), time.Minute)
+	var reads int
+	r.read = func(s []metrics.Sample) {
+		reads++
+		metrics.Read(s)
+	}
+	now := time.Now()
+	r.now = func() time.Time { return now }
+	require.NoError(t, r.register())
+
+	_ = collect(t, rdr)
+	assert.Equal(t, 1, reads, "runtime metrics not read")
+
+	now = now.Add(time.Second)
+	got := collect(t, rdr)
+	assert.Equal(t, 1, reads, "runtime metrics read before minimum interval")
+	// The values of the previous read are still reported.
+	assert.Greater(t, int64Value(t, got["process.runtime.go.goroutines"]), int64(0))
+
+	now = now.Add(time.Minute)
+	_ = collect(t, rdr)
+	assert.Equal(t, 2, reads, "runtime metrics not read after minimum interval")
+}
+
+func TestBucketValue(t *testing.T) {
+	inf := math.Inf(1)
+	assert.Equal(t, 1.0, bucketValue(-inf, 1))
+	assert.Equal(t, 2.0, bucketValue(2, inf))
+	assert.Equal(t, 1.5, bucketValue(1, 2))
+}
+
+func TestNewConfig(t *testing.T) {
+	cfg := newConfig()
+	assert.NotNil(t, cfg.meterProvider)
+	assert.Equal(t, DefaultMinimumReadInterval, cfg.minimumReadInterval)
+
+	cfg = newConfig(WithMinimumReadInterval(-time.Second))
+	assert.Equal(t, DefaultMinimumReadInterval, cfg.minimumReadInterval)
+
+	mp := sdkmetric.NewMeterProvider()
+	cfg = newConfig(WithMeterProvider(mp), WithMinimumReadInterval(time.Second))
+	assert.Same(t, mp, cfg.meterProvider)
+	assert.Equal(t, time.Second, cfg.minimumReadInterval)
+}
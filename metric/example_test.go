@@ -61,14 +61,14 @@ func ExampleMeter_asynchronous_single() {
 		panic(err)
 	}
 
-	err = meter.RegisterCallback([]instrument.Asynchronous{memoryUsage},
-		func(ctx context.Context) {
+	_, err = meter.RegisterCallback([]instrument.Asynchronous{memoryUsage},
+		func(ctx context.Context, o metric.Observer) {
 			// instrument.WithCallbackFunc(func(ctx context.Context) {
 			//Do Work to get the real memoryUsage
 			// mem := GatherMemory(ctx)
 			mem := 75000
 
-			memoryUsage.Observe(ctx, int64(mem))
+			o.ObserveInt64(memoryUsage, int64(mem))
 		})
 	if err != nil {
 		fmt.Println("Failed to register callback")
@@ -86,17 +86,17 @@ func ExampleMeter_asynchronous_multiple() {
 	gcCount, _ := meter.AsyncInt64().Counter("gcCount")
 	gcPause, _ := meter.SyncFloat64().Histogram("gcPause")
 
-	err := meter.RegisterCallback([]instrument.Asynchronous{
+	reg, err := meter.RegisterCallback([]instrument.Asynchronous{
 		heapAlloc,
 		gcCount,
 	},
-		func(ctx context.Context) {
+		func(ctx context.Context, o metric.Observer) {
 			memStats := &runtime.MemStats{}
 			// This call does work
 			runtime.ReadMemStats(memStats)
 
-			heapAlloc.Observe(ctx, int64(memStats.HeapAlloc))
-			gcCount.Observe(ctx, int64(memStats.NumGC))
+			o.ObserveInt64(heapAlloc, int64(memStats.HeapAlloc))
+			o.ObserveInt64(gcCount, int64(memStats.NumGC))
 
 			// This function synchronously records the pauses
 			computeGCPauses(ctx, gcPause, memStats.PauseNs[:])
@@ -107,6 +107,12 @@ func ExampleMeter_asynchronous_multiple() {
 		fmt.Println("Failed to register callback")
 		panic(err)
 	}
+
+	// The callback is no longer called once it is unregistered.
+	if err := reg.Unregister(); err != nil {
+		fmt.Println("Failed to unregister callback")
+		panic(err)
+	}
 }
 
 // This is just an example, see the the contrib runtime instrumentation for real implementation.
@@ -20,6 +20,7 @@ import "go.opentelemetry.io/otel/metric/unit"
 type Config struct {
 	description string
 	unit        unit.Unit
+	boundaries  []float64
 }
 
 // Description describes the instrument in human-readable terms.
@@ -32,6 +33,17 @@ func (cfg Config) Unit() unit.Unit {
 	return cfg.unit
 }
 
+// ExplicitBucketBoundaries returns the advised bucket boundaries of a
+// histogram instrument. If no boundaries were advised, nil is returned.
+func (cfg Config) ExplicitBucketBoundaries() []float64 {
+	if cfg.boundaries == nil {
+		return nil
+	}
+	b := make([]float64, len(cfg.boundaries))
+	copy(b, cfg.boundaries)
+	return b
+}
+
 // Option is an interface for applying metric instrument options.
 type Option interface {
 	applyInstrument(Config) Config
@@ -60,6 +72,22 @@ func WithDescription(desc string) Option {
 	})
 }
 
+// WithExplicitBucketBoundaries advises the bucket boundaries to use when
+// aggregating the measurements of a histogram instrument with an explicit
+// bucket histogram. The boundaries need to be sorted in increasing order.
+//
+// This is advice an implementation may ignore. It is ignored by instruments
+// that are not histograms, and the boundaries configured by a view for an
+// instrument take precedence over it.
+func WithExplicitBucketBoundaries(bounds ...float64) Option {
+	b := make([]float64, len(bounds))
+	copy(b, bounds)
+	return optionFunc(func(cfg Config) Config {
+		cfg.boundaries = b
+		return cfg
+	})
+}
+
 // WithUnit applies provided unit.
 func WithUnit(u unit.Unit) Option {
 	return optionFunc(func(cfg Config) Config {
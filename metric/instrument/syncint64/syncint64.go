@@ -31,6 +31,8 @@ type InstrumentProvider interface {
 	UpDownCounter(name string, opts ...instrument.Option) (UpDownCounter, error)
 	// Histogram creates an instrument for recording a distribution of values.
 	Histogram(name string, opts ...instrument.Option) (Histogram, error)
+	// Gauge creates an instrument for recording the current value.
+	Gauge(name string, opts ...instrument.Option) (Gauge, error)
 }
 
 // Counter is an instrument that records increasing values.
@@ -39,6 +41,12 @@ type InstrumentProvider interface {
 type Counter interface {
 	// Add records a change to the counter.
 	Add(ctx context.Context, incr int64, attrs ...attribute.KeyValue)
+	// AddSet records a change to the counter with the attribute set attrs.
+	//
+	// This avoids computing the attribute set for each measurement. It
+	// should be used when the same attributes are used for many
+	// measurements.
+	AddSet(ctx context.Context, incr int64, attrs attribute.Set)
 
 	instrument.Synchronous
 }
@@ -49,6 +57,12 @@ type Counter interface {
 type UpDownCounter interface {
 	// Add records a change to the counter.
 	Add(ctx context.Context, incr int64, attrs ...attribute.KeyValue)
+	// AddSet records a change to the counter with the attribute set attrs.
+	//
+	// This avoids computing the attribute set for each measurement. It
+	// should be used when the same attributes are used for many
+	// measurements.
+	AddSet(ctx context.Context, incr int64, attrs attribute.Set)
 
 	instrument.Synchronous
 }
@@ -59,6 +73,29 @@ type UpDownCounter interface {
 type Histogram interface {
 	// Record adds an additional value to the distribution.
 	Record(ctx context.Context, incr int64, attrs ...attribute.KeyValue)
+	// RecordSet adds an additional value to the distribution with the
+	// attribute set attrs.
+	//
+	// This avoids computing the attribute set for each measurement. It
+	// should be used when the same attributes are used for many
+	// measurements.
+	RecordSet(ctx context.Context, incr int64, attrs attribute.Set)
+
+	instrument.Synchronous
+}
+
+// Gauge is an instrument that records the current value.
+//
+// Warning: methods may be added to this interface in minor releases.
+type Gauge interface {
+	// Record records the current value.
+	Record(ctx context.Context, value int64, attrs ...attribute.KeyValue)
+	// RecordSet records the current value with the attribute set attrs.
+	//
+	// This avoids computing the attribute set for each measurement. It
+	// should be used when the same attributes are used for many
+	// measurements.
+	RecordSet(ctx context.Context, value int64, attrs attribute.Set)
 
 	instrument.Synchronous
 }
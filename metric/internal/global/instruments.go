@@ -239,6 +239,12 @@ func (i *sfCounter) Add(ctx context.Context, incr float64, attrs ...attribute.Ke
 	}
 }
 
+func (i *sfCounter) AddSet(ctx context.Context, incr float64, attrs attribute.Set) {
+	if ctr := i.delegate.Load(); ctr != nil {
+		ctr.(syncfloat64.Counter).AddSet(ctx, incr, attrs)
+	}
+}
+
 type sfUpDownCounter struct {
 	name string
 	opts []instrument.Option
@@ -263,6 +269,12 @@ func (i *sfUpDownCounter) Add(ctx context.Context, incr float64, attrs ...attrib
 	}
 }
 
+func (i *sfUpDownCounter) AddSet(ctx context.Context, incr float64, attrs attribute.Set) {
+	if ctr := i.delegate.Load(); ctr != nil {
+		ctr.(syncfloat64.UpDownCounter).AddSet(ctx, incr, attrs)
+	}
+}
+
 type sfHistogram struct {
 	name string
 	opts []instrument.Option
@@ -287,6 +299,42 @@ func (i *sfHistogram) Record(ctx context.Context, x float64, attrs ...attribute.
 	}
 }
 
+func (i *sfHistogram) RecordSet(ctx context.Context, x float64, attrs attribute.Set) {
+	if ctr := i.delegate.Load(); ctr != nil {
+		ctr.(syncfloat64.Histogram).RecordSet(ctx, x, attrs)
+	}
+}
+
+type sfGauge struct {
+	name string
+	opts []instrument.Option
+
+	delegate atomic.Value //syncfloat64.Gauge
+
+	instrument.Synchronous
+}
+
+func (i *sfGauge) setDelegate(m metric.Meter) {
+	ctr, err := m.SyncFloat64().Gauge(i.name, i.opts...)
+	if err != nil {
+		otel.Handle(err)
+		return
+	}
+	i.delegate.Store(ctr)
+}
+
+func (i *sfGauge) Record(ctx context.Context, x float64, attrs ...attribute.KeyValue) {
+	if ctr := i.delegate.Load(); ctr != nil {
+		ctr.(syncfloat64.Gauge).Record(ctx, x, attrs...)
+	}
+}
+
+func (i *sfGauge) RecordSet(ctx context.Context, x float64, attrs attribute.Set) {
+	if ctr := i.delegate.Load(); ctr != nil {
+		ctr.(syncfloat64.Gauge).RecordSet(ctx, x, attrs)
+	}
+}
+
 type siCounter struct {
 	name string
 	opts []instrument.Option
@@ -311,6 +359,12 @@ func (i *siCounter) Add(ctx context.Context, x int64, attrs ...attribute.KeyValu
 	}
 }
 
+func (i *siCounter) AddSet(ctx context.Context, x int64, attrs attribute.Set) {
+	if ctr := i.delegate.Load(); ctr != nil {
+		ctr.(syncint64.Counter).AddSet(ctx, x, attrs)
+	}
+}
+
 type siUpDownCounter struct {
 	name string
 	opts []instrument.Option
@@ -335,6 +389,12 @@ func (i *siUpDownCounter) Add(ctx context.Context, x int64, attrs ...attribute.K
 	}
 }
 
+func (i *siUpDownCounter) AddSet(ctx context.Context, x int64, attrs attribute.Set) {
+	if ctr := i.delegate.Load(); ctr != nil {
+		ctr.(syncint64.UpDownCounter).AddSet(ctx, x, attrs)
+	}
+}
+
 type siHistogram struct {
 	name string
 	opts []instrument.Option
@@ -358,3 +418,39 @@ func (i *siHistogram) Record(ctx context.Context, x int64, attrs ...attribute.Ke
 		ctr.(syncint64.Histogram).Record(ctx, x, attrs...)
 	}
 }
+
+func (i *siHistogram) RecordSet(ctx context.Context, x int64, attrs attribute.Set) {
+	if ctr := i.delegate.Load(); ctr != nil {
+		ctr.(syncint64.Histogram).RecordSet(ctx, x, attrs)
+	}
+}
+
+type siGauge struct {
+	name string
+	opts []instrument.Option
+
+	delegate atomic.Value //syncint64.Gauge
+
+	instrument.Synchronous
+}
+
+func (i *siGauge) setDelegate(m metric.Meter) {
+	ctr, err := m.SyncInt64().Gauge(i.name, i.opts...)
+	if err != nil {
+		otel.Handle(err)
+		return
+	}
+	i.delegate.Store(ctr)
+}
+
+func (i *siGauge) Record(ctx context.Context, x int64, attrs ...attribute.KeyValue) {
+	if ctr := i.delegate.Load(); ctr != nil {
+		ctr.(syncint64.Gauge).Record(ctx, x, attrs...)
+	}
+}
+
+func (i *siGauge) RecordSet(ctx context.Context, x int64, attrs attribute.Set) {
+	if ctr := i.delegate.Load(); ctr != nil {
+		ctr.(syncint64.Gauge).RecordSet(ctx, x, attrs)
+	}
+}
@@ -18,6 +18,8 @@ import (
 	"context"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/metric/instrument"
@@ -113,6 +115,11 @@ func TestSyncInstrumentSetDelegateRace(t *testing.T) {
 			delegate := &sfHistogram{}
 			testFloat64Race(delegate.Record, delegate.setDelegate)
 		})
+
+		t.Run("Gauge", func(t *testing.T) {
+			delegate := &sfGauge{}
+			testFloat64Race(delegate.Record, delegate.setDelegate)
+		})
 	})
 
 	// Int64 Instruments
@@ -132,6 +139,63 @@ func TestSyncInstrumentSetDelegateRace(t *testing.T) {
 			delegate := &siHistogram{}
 			testInt64Race(delegate.Record, delegate.setDelegate)
 		})
+
+		t.Run("Gauge", func(t *testing.T) {
+			delegate := &siGauge{}
+			testInt64Race(delegate.Record, delegate.setDelegate)
+		})
+	})
+}
+
+func TestSyncInstrumentSetMethodsDelegate(t *testing.T) {
+	ctx := context.Background()
+	set := attribute.NewSet(attribute.String("key", "value"))
+	m := &testMeter{}
+
+	t.Run("Float64", func(t *testing.T) {
+		ctr := &sfCounter{}
+		ctr.AddSet(ctx, 1, set) // Not delegated, dropped.
+		ctr.setDelegate(m)
+		ctr.AddSet(ctx, 1, set)
+		assert.Equal(t, 1, ctr.delegate.Load().(*testCountingFloatInstrument).count)
+
+		udc := &sfUpDownCounter{}
+		udc.setDelegate(m)
+		udc.AddSet(ctx, 1, set)
+		assert.Equal(t, 1, udc.delegate.Load().(*testCountingFloatInstrument).count)
+
+		hist := &sfHistogram{}
+		hist.setDelegate(m)
+		hist.RecordSet(ctx, 1, set)
+		assert.Equal(t, 1, hist.delegate.Load().(*testCountingFloatInstrument).count)
+
+		gauge := &sfGauge{}
+		gauge.setDelegate(m)
+		gauge.RecordSet(ctx, 1, set)
+		assert.Equal(t, 1, gauge.delegate.Load().(*testCountingFloatInstrument).count)
+	})
+
+	t.Run("Int64", func(t *testing.T) {
+		ctr := &siCounter{}
+		ctr.AddSet(ctx, 1, set) // Not delegated, dropped.
+		ctr.setDelegate(m)
+		ctr.AddSet(ctx, 1, set)
+		assert.Equal(t, 1, ctr.delegate.Load().(*testCountingIntInstrument).count)
+
+		udc := &siUpDownCounter{}
+		udc.setDelegate(m)
+		udc.AddSet(ctx, 1, set)
+		assert.Equal(t, 1, udc.delegate.Load().(*testCountingIntInstrument).count)
+
+		hist := &siHistogram{}
+		hist.setDelegate(m)
+		hist.RecordSet(ctx, 1, set)
+		assert.Equal(t, 1, hist.delegate.Load().(*testCountingIntInstrument).count)
+
+		gauge := &siGauge{}
+		gauge.setDelegate(m)
+		gauge.RecordSet(ctx, 1, set)
+		assert.Equal(t, 1, gauge.delegate.Load().(*testCountingIntInstrument).count)
 	})
 }
 
@@ -151,6 +215,12 @@ func (i *testCountingFloatInstrument) Add(context.Context, float64, ...attribute
 func (i *testCountingFloatInstrument) Record(context.Context, float64, ...attribute.KeyValue) {
 	i.count++
 }
+func (i *testCountingFloatInstrument) AddSet(context.Context, float64, attribute.Set) {
+	i.count++
+}
+func (i *testCountingFloatInstrument) RecordSet(context.Context, float64, attribute.Set) {
+	i.count++
+}
 
 type testCountingIntInstrument struct {
 	count int
@@ -168,3 +238,9 @@ func (i *testCountingIntInstrument) Add(context.Context, int64, ...attribute.Key
 func (i *testCountingIntInstrument) Record(context.Context, int64, ...attribute.KeyValue) {
 	i.count++
 }
+func (i *testCountingIntInstrument) AddSet(context.Context, int64, attribute.Set) {
+	i.count++
+}
+func (i *testCountingIntInstrument) RecordSet(context.Context, int64, attribute.Set) {
+	i.count++
+}
@@ -15,11 +15,13 @@
 package global // import "go.opentelemetry.io/otel/metric/internal/global"
 
 import (
+	"container/list"
 	"context"
 	"sync"
 	"sync/atomic"
 
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/metric/instrument"
 	"go.opentelemetry.io/otel/metric/instrument/asyncfloat64"
@@ -109,7 +111,7 @@ type meter struct {
 
 	mtx         sync.Mutex
 	instruments []delegatedInstrument
-	callbacks   []delegatedCallback
+	registry    list.List
 
 	delegate atomic.Value // metric.Meter
 }
@@ -135,12 +137,13 @@ func (m *meter) setDelegate(provider metric.MeterProvider) {
 		inst.setDelegate(meter)
 	}
 
-	for _, callback := range m.callbacks {
-		callback.setDelegate(meter)
+	for e := m.registry.Front(); e != nil; e = e.Next() {
+		r := e.Value.(*registration)
+		r.setDelegate(meter)
 	}
 
 	m.instruments = nil
-	m.callbacks = nil
+	m.registry.Init()
 }
 
 // AsyncInt64 is the namespace for the Asynchronous Integer instruments.
@@ -165,22 +168,27 @@ func (m *meter) AsyncFloat64() asyncfloat64.InstrumentProvider {
 
 // RegisterCallback captures the function that will be called during Collect.
 //
-// It is only valid to call Observe within the scope of the passed function,
-// and only on the instruments that were registered with this call.
-func (m *meter) RegisterCallback(insts []instrument.Asynchronous, function func(context.Context)) error {
+// The Observer passed to function is only valid to use within the scope of
+// that call, and only for the instruments that were registered with this
+// call.
+func (m *meter) RegisterCallback(insts []instrument.Asynchronous, function metric.Callback) (metric.Registration, error) {
 	if del, ok := m.delegate.Load().(metric.Meter); ok {
 		insts = unwrapInstruments(insts)
-		return del.RegisterCallback(insts, function)
+		return del.RegisterCallback(insts, unwrapCallback(function))
 	}
 
 	m.mtx.Lock()
 	defer m.mtx.Unlock()
-	m.callbacks = append(m.callbacks, delegatedCallback{
-		instruments: insts,
-		function:    function,
-	})
 
-	return nil
+	reg := &registration{instruments: insts, function: function}
+	e := m.registry.PushBack(reg)
+	reg.unreg = func() error {
+		m.mtx.Lock()
+		_ = m.registry.Remove(e)
+		m.mtx.Unlock()
+		return nil
+	}
+	return reg, nil
 }
 
 type wrapped interface {
@@ -191,11 +199,7 @@ func unwrapInstruments(instruments []instrument.Asynchronous) []instrument.Async
 	out := make([]instrument.Asynchronous, 0, len(instruments))
 
 	for _, inst := range instruments {
-		if in, ok := inst.(wrapped); ok {
-			out = append(out, in.unwrap())
-		} else {
-			out = append(out, inst)
-		}
+		out = append(out, unwrap(inst))
 	}
 
 	return out
@@ -217,17 +221,81 @@ func (m *meter) SyncFloat64() syncfloat64.InstrumentProvider {
 	return (*sfInstProvider)(m)
 }
 
-type delegatedCallback struct {
+// unwrapCallback returns a Callback that calls f with an Observer that
+// unwraps any instruments from this package before making observations with
+// the Observer of a delegate Meter.
+func unwrapCallback(f metric.Callback) metric.Callback {
+	return func(ctx context.Context, o metric.Observer) {
+		f(ctx, unwrapObserver{o})
+	}
+}
+
+type unwrapObserver struct {
+	observer metric.Observer
+}
+
+func unwrap(inst instrument.Asynchronous) instrument.Asynchronous {
+	if in, ok := inst.(wrapped); ok {
+		return in.unwrap()
+	}
+	return inst
+}
+
+func (o unwrapObserver) ObserveFloat64(inst instrument.Asynchronous, value float64, attrs ...attribute.KeyValue) {
+	if inst = unwrap(inst); inst != nil {
+		o.observer.ObserveFloat64(inst, value, attrs...)
+	}
+}
+
+func (o unwrapObserver) ObserveInt64(inst instrument.Asynchronous, value int64, attrs ...attribute.KeyValue) {
+	if inst = unwrap(inst); inst != nil {
+		o.observer.ObserveInt64(inst, value, attrs...)
+	}
+}
+
+type registration struct {
 	instruments []instrument.Asynchronous
-	function    func(context.Context)
+	function    metric.Callback
+
+	unregMu sync.Mutex
+	unreg   func() error
 }
 
-func (c *delegatedCallback) setDelegate(m metric.Meter) {
+func (c *registration) setDelegate(m metric.Meter) {
+	c.unregMu.Lock()
+	defer c.unregMu.Unlock()
+
+	if c.unreg == nil {
+		// Unregister already called.
+		return
+	}
+
 	insts := unwrapInstruments(c.instruments)
-	err := m.RegisterCallback(insts, c.function)
+	reg, err := m.RegisterCallback(insts, unwrapCallback(c.function))
 	if err != nil {
 		otel.Handle(err)
+		// The callback is not registered with the delegate and the
+		// registration with this package is removed once delegated. There
+		// is nothing left to unregister.
+		c.unreg = nil
+		return
+	}
+	c.unreg = reg.Unregister
+}
+
+// Unregister removes the callback registration from the Meter, or from its
+// delegate if one has been set.
+func (c *registration) Unregister() error {
+	c.unregMu.Lock()
+	unreg := c.unreg
+	c.unreg = nil
+	c.unregMu.Unlock()
+
+	if unreg == nil {
+		// Unregister already called.
+		return nil
 	}
+	return unreg()
 }
 
 type afInstProvider meter
@@ -317,6 +385,15 @@ func (ip *sfInstProvider) Histogram(name string, opts ...instrument.Option) (syn
 	return ctr, nil
 }
 
+// Gauge creates an instrument for recording the current value.
+func (ip *sfInstProvider) Gauge(name string, opts ...instrument.Option) (syncfloat64.Gauge, error) {
+	ip.mtx.Lock()
+	defer ip.mtx.Unlock()
+	ctr := &sfGauge{name: name, opts: opts}
+	ip.instruments = append(ip.instruments, ctr)
+	return ctr, nil
+}
+
 type siInstProvider meter
 
 // Counter creates an instrument for recording increasing values.
@@ -345,3 +422,12 @@ func (ip *siInstProvider) Histogram(name string, opts ...instrument.Option) (syn
 	ip.instruments = append(ip.instruments, ctr)
 	return ctr, nil
 }
+
+// Gauge creates an instrument for recording the current value.
+func (ip *siInstProvider) Gauge(name string, opts ...instrument.Option) (syncint64.Gauge, error) {
+	ip.mtx.Lock()
+	defer ip.mtx.Unlock()
+	ctr := &siGauge{name: name, opts: opts}
+	ip.instruments = append(ip.instruments, ctr)
+	return ctr, nil
+}
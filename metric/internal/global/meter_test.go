@@ -16,13 +16,16 @@ package global // import "go.opentelemetry.io/otel/metric/internal/global"
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log"
 	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/metric/instrument"
 	"go.opentelemetry.io/otel/metric/instrument/asyncfloat64"
@@ -65,10 +68,12 @@ func TestMeterRace(t *testing.T) {
 			_, _ = mtr.SyncFloat64().Counter(name)
 			_, _ = mtr.SyncFloat64().UpDownCounter(name)
 			_, _ = mtr.SyncFloat64().Histogram(name)
+			_, _ = mtr.SyncFloat64().Gauge(name)
 			_, _ = mtr.SyncInt64().Counter(name)
 			_, _ = mtr.SyncInt64().UpDownCounter(name)
 			_, _ = mtr.SyncInt64().Histogram(name)
-			_ = mtr.RegisterCallback(nil, func(ctx context.Context) {})
+			_, _ = mtr.SyncInt64().Gauge(name)
+			_, _ = mtr.RegisterCallback(nil, func(context.Context, metric.Observer) {})
 			if !once {
 				wg.Done()
 				once = true
@@ -101,9 +106,10 @@ func testSetupAllInstrumentTypes(t *testing.T, m metric.Meter) (syncfloat64.Coun
 	_, err = m.AsyncInt64().Gauge("test_Async_Gauge")
 	assert.NoError(t, err)
 
-	require.NoError(t, m.RegisterCallback([]instrument.Asynchronous{afcounter}, func(ctx context.Context) {
-		afcounter.Observe(ctx, 3)
-	}))
+	_, err = m.RegisterCallback([]instrument.Asynchronous{afcounter}, func(_ context.Context, o metric.Observer) {
+		o.ObserveFloat64(afcounter, 3)
+	})
+	require.NoError(t, err)
 
 	sfcounter, err := m.SyncFloat64().Counter("test_Async_Counter")
 	require.NoError(t, err)
@@ -111,6 +117,8 @@ func testSetupAllInstrumentTypes(t *testing.T, m metric.Meter) (syncfloat64.Coun
 	assert.NoError(t, err)
 	_, err = m.SyncFloat64().Histogram("test_Async_Histogram")
 	assert.NoError(t, err)
+	_, err = m.SyncFloat64().Gauge("test_Sync_Gauge")
+	assert.NoError(t, err)
 
 	_, err = m.SyncInt64().Counter("test_Async_Counter")
 	assert.NoError(t, err)
@@ -118,6 +126,8 @@ func testSetupAllInstrumentTypes(t *testing.T, m metric.Meter) (syncfloat64.Coun
 	assert.NoError(t, err)
 	_, err = m.SyncInt64().Histogram("test_Async_Histogram")
 	assert.NoError(t, err)
+	_, err = m.SyncInt64().Gauge("test_Sync_Gauge")
+	assert.NoError(t, err)
 
 	return sfcounter, afcounter
 }
@@ -166,8 +176,8 @@ func TestMeterProviderDelegatesCalls(t *testing.T) {
 	tMeter := meter.(*testMeter)
 	assert.Equal(t, 3, tMeter.afCount)
 	assert.Equal(t, 3, tMeter.aiCount)
-	assert.Equal(t, 3, tMeter.sfCount)
-	assert.Equal(t, 3, tMeter.siCount)
+	assert.Equal(t, 4, tMeter.sfCount)
+	assert.Equal(t, 4, tMeter.siCount)
 	assert.Equal(t, 1, len(tMeter.callbacks))
 
 	// Because the Meter was provided by testmeterProvider it should also return our test instrument
@@ -208,8 +218,8 @@ func TestMeterDelegatesCalls(t *testing.T) {
 	require.NotNil(t, tMeter)
 	assert.Equal(t, 3, tMeter.afCount)
 	assert.Equal(t, 3, tMeter.aiCount)
-	assert.Equal(t, 3, tMeter.sfCount)
-	assert.Equal(t, 3, tMeter.siCount)
+	assert.Equal(t, 4, tMeter.sfCount)
+	assert.Equal(t, 4, tMeter.siCount)
 
 	// Because the Meter was provided by testmeterProvider it should also return our test instrument
 	require.IsType(t, &testCountingFloatInstrument{}, ctr, "the meter did not delegate calls to the meter")
@@ -248,12 +258,119 @@ func TestMeterDefersDelegations(t *testing.T) {
 	require.NotNil(t, tMeter)
 	assert.Equal(t, 3, tMeter.afCount)
 	assert.Equal(t, 3, tMeter.aiCount)
-	assert.Equal(t, 3, tMeter.sfCount)
-	assert.Equal(t, 3, tMeter.siCount)
+	assert.Equal(t, 4, tMeter.sfCount)
+	assert.Equal(t, 4, tMeter.siCount)
 
 	// Because the Meter was a delegate it should return a delegated instrument
 
 	assert.IsType(t, &sfCounter{}, ctr)
 	assert.IsType(t, &afCounter{}, actr)
 	assert.Equal(t, 1, mp.count)
+
+	// The callback registered before delegation should observe the delegate
+	// of the instrument it was registered with.
+	require.Len(t, tMeter.callbacks, 1)
+	require.IsType(t, &testCountingFloatInstrument{}, actr.(*afCounter).unwrap())
+	assert.Equal(t, 1, actr.(*afCounter).unwrap().(*testCountingFloatInstrument).count)
+}
+
+func TestRegistrationDelegation(t *testing.T) {
+	// globalMeterProvider := otel.GetMeterProvider
+	globalMeterProvider := &meterProvider{}
+
+	m := globalMeterProvider.Meter("go.opentelemetry.io/otel/metric/internal/global/meter_test")
+	require.IsType(t, &meter{}, m)
+	mImpl := m.(*meter)
+
+	actr, err := m.AsyncFloat64().Counter("test_Async_Counter")
+	require.NoError(t, err)
+
+	var called0 bool
+	reg0, err := m.RegisterCallback([]instrument.Asynchronous{actr}, func(context.Context, metric.Observer) {
+		called0 = true
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, mImpl.registry.Len(), "callback not registered")
+	// This means reg0 should not be delegated.
+	assert.NoError(t, reg0.Unregister())
+	assert.Equal(t, 0, mImpl.registry.Len(), "callback not unregistered")
+
+	var called1 bool
+	reg1, err := m.RegisterCallback([]instrument.Asynchronous{actr}, func(context.Context, metric.Observer) {
+		called1 = true
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, mImpl.registry.Len(), "second callback not registered")
+
+	mp := &testMeterProvider{}
+
+	// otel.SetMeterProvider(mp)
+	globalMeterProvider.setDelegate(mp)
+
+	testCollect(t, m) // This is a hacky way to emulate a read from an exporter
+	require.False(t, called0, "pre-delegation unregistered callback called")
+	require.True(t, called1, "callback not called")
+
+	called1 = false
+	assert.NoError(t, reg1.Unregister(), "unregister second callback")
+
+	testCollect(t, m) // This is a hacky way to emulate a read from an exporter
+	assert.False(t, called1, "unregistered callback called")
+
+	assert.NotPanics(t, func() {
+		assert.NoError(t, reg1.Unregister(), "duplicate unregister calls")
+	})
+}
+
+type failingRegMeterProvider struct {
+	testMeterProvider
+}
+
+func (p *failingRegMeterProvider) Meter(name string, opts ...metric.MeterOption) metric.Meter {
+	return &failingRegMeter{testMeter: p.testMeterProvider.Meter(name, opts...).(*testMeter)}
+}
+
+type failingRegMeter struct {
+	*testMeter
+}
+
+var errRegister = errors.New("register callback failed")
+
+func (m *failingRegMeter) RegisterCallback([]instrument.Asynchronous, metric.Callback) (metric.Registration, error) {
+	return nil, errRegister
+}
+
+// setErrorHandler makes h handle the errors of the test. The global error
+// handler delegates to h, once the test ends it delegates to a handler
+// logging errors.
+func setErrorHandler(t *testing.T, h otel.ErrorHandler) {
+	otel.SetErrorHandler(h)
+	t.Cleanup(func() {
+		otel.SetErrorHandler(otel.ErrorHandlerFunc(func(err error) {
+			log.Print(err)
+		}))
+	})
+}
+
+func TestRegistrationDelegationFailure(t *testing.T) {
+	var handled []error
+	setErrorHandler(t, otel.ErrorHandlerFunc(func(err error) {
+		handled = append(handled, err)
+	}))
+
+	globalMeterProvider := &meterProvider{}
+	m := globalMeterProvider.Meter("go.opentelemetry.io/otel/metric/internal/global/meter_test")
+	mImpl := m.(*meter)
+
+	actr, err := m.AsyncFloat64().Counter("test_Async_Counter")
+	require.NoError(t, err)
+	reg, err := m.RegisterCallback([]instrument.Asynchronous{actr}, func(context.Context, metric.Observer) {})
+	require.NoError(t, err)
+
+	globalMeterProvider.setDelegate(&failingRegMeterProvider{})
+	assert.Equal(t, []error{errRegister}, handled)
+	require.Equal(t, 0, mImpl.registry.Len())
+
+	assert.NoError(t, reg.Unregister())
+	assert.Equal(t, 0, mImpl.registry.Len(), "emptied registry modified")
 }
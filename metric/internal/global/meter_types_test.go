@@ -17,6 +17,7 @@ package global // import "go.opentelemetry.io/otel/metric/internal/global"
 import (
 	"context"
 
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/metric/instrument"
 	"go.opentelemetry.io/otel/metric/instrument/asyncfloat64"
@@ -41,7 +42,7 @@ type testMeter struct {
 	sfCount int
 	siCount int
 
-	callbacks []func(context.Context)
+	callbacks []metric.Callback
 }
 
 // AsyncInt64 is the namespace for the Asynchronous Integer instruments.
@@ -62,10 +63,24 @@ func (m *testMeter) AsyncFloat64() asyncfloat64.InstrumentProvider {
 
 // RegisterCallback captures the function that will be called during Collect.
 //
-// It is only valid to call Observe within the scope of the passed function,
-// and only on the instruments that were registered with this call.
-func (m *testMeter) RegisterCallback(insts []instrument.Asynchronous, function func(context.Context)) error {
+// The Observer passed to function is only valid to use within the scope of
+// that call, and only for the instruments that were registered with this
+// call.
+func (m *testMeter) RegisterCallback(insts []instrument.Asynchronous, function metric.Callback) (metric.Registration, error) {
 	m.callbacks = append(m.callbacks, function)
+	return testReg{
+		f: func(idx int) func() {
+			return func() { m.callbacks[idx] = nil }
+		}(len(m.callbacks) - 1),
+	}, nil
+}
+
+type testReg struct {
+	f func()
+}
+
+func (r testReg) Unregister() error {
+	r.f()
 	return nil
 }
 
@@ -84,8 +99,31 @@ func (m *testMeter) SyncFloat64() syncfloat64.InstrumentProvider {
 // This enables async collection.
 func (m *testMeter) collect() {
 	ctx := context.Background()
+	o := observationRecorder{ctx}
 	for _, f := range m.callbacks {
-		f(ctx)
+		if f == nil {
+			// Unregistered.
+			continue
+		}
+		f(ctx, o)
+	}
+}
+
+type observationRecorder struct {
+	ctx context.Context
+}
+
+func (o observationRecorder) ObserveFloat64(i instrument.Asynchronous, value float64, attr ...attribute.KeyValue) {
+	iImpl, ok := i.(*testCountingFloatInstrument)
+	if ok {
+		iImpl.Observe(o.ctx, value, attr...)
+	}
+}
+
+func (o observationRecorder) ObserveInt64(i instrument.Asynchronous, value int64, attr ...attribute.KeyValue) {
+	iImpl, ok := i.(*testCountingIntInstrument)
+	if ok {
+		iImpl.Observe(o.ctx, value, attr...)
 	}
 }
 
@@ -140,6 +178,11 @@ func (ip testSFInstrumentProvider) Histogram(name string, opts ...instrument.Opt
 	return &testCountingFloatInstrument{}, nil
 }
 
+// Gauge creates an instrument for recording the current value.
+func (ip testSFInstrumentProvider) Gauge(name string, opts ...instrument.Option) (syncfloat64.Gauge, error) {
+	return &testCountingFloatInstrument{}, nil
+}
+
 type testSIInstrumentProvider struct{}
 
 // Counter creates an instrument for recording increasing values.
@@ -156,3 +199,8 @@ func (ip testSIInstrumentProvider) UpDownCounter(name string, opts ...instrument
 func (ip testSIInstrumentProvider) Histogram(name string, opts ...instrument.Option) (syncint64.Histogram, error) {
 	return &testCountingIntInstrument{}, nil
 }
+
+// Gauge creates an instrument for recording the current value.
+func (ip testSIInstrumentProvider) Gauge(name string, opts ...instrument.Option) (syncint64.Gauge, error) {
+	return &testCountingIntInstrument{}, nil
+}
@@ -17,6 +17,7 @@ package metric // import "go.opentelemetry.io/otel/metric"
 import (
 	"context"
 
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric/instrument"
 	"go.opentelemetry.io/otel/metric/instrument/asyncfloat64"
 	"go.opentelemetry.io/otel/metric/instrument/asyncint64"
@@ -51,14 +52,44 @@ type Meter interface {
 	// To Observe data with instruments it must be registered in a callback.
 	AsyncFloat64() asyncfloat64.InstrumentProvider
 
-	// RegisterCallback captures the function that will be called during Collect.
+	// RegisterCallback registers function to be called during Collect. The
+	// returned Registration can be used to unregister function.
 	//
-	// It is only valid to call Observe within the scope of the passed function,
-	// and only on the instruments that were registered with this call.
-	RegisterCallback(insts []instrument.Asynchronous, function func(context.Context)) error
+	// The Observer passed to function is only valid to use within the scope
+	// of that call, and only to make observations for the instruments that
+	// were registered with this call.
+	RegisterCallback(insts []instrument.Asynchronous, function Callback) (Registration, error)
 
 	// SyncInt64 is the namespace for the Synchronous Integer instruments
 	SyncInt64() syncint64.InstrumentProvider
 	// SyncFloat64 is the namespace for the Synchronous Float instruments
 	SyncFloat64() syncfloat64.InstrumentProvider
 }
+
+// Callback is a function registered with a Meter that makes observations for
+// the set of instruments it is registered with. The Observer parameter is
+// used to record measurements for those instruments.
+type Callback func(context.Context, Observer)
+
+// Observer records measurements for the asynchronous instruments a Callback
+// is registered with.
+//
+// Warning: methods may be added to this interface in minor releases.
+type Observer interface {
+	// ObserveFloat64 records the float64 value with attributes for inst.
+	ObserveFloat64(inst instrument.Asynchronous, value float64, attrs ...attribute.KeyValue)
+	// ObserveInt64 records the int64 value with attributes for inst.
+	ObserveInt64(inst instrument.Asynchronous, value int64, attrs ...attribute.KeyValue)
+}
+
+// Registration is a token representing the unique registration of a Callback
+// for a set of instruments with a Meter.
+//
+// Warning: methods may be added to this interface in minor releases.
+type Registration interface {
+	// Unregister removes the Callback registration from the Meter.
+	//
+	// This method needs to be idempotent and concurrent safe. It must not be
+	// called from within the registered Callback.
+	Unregister() error
+}
@@ -64,7 +64,14 @@ func (noopMeter) SyncFloat64() syncfloat64.InstrumentProvider {
 }
 
 // RegisterCallback creates a register callback that does not record any metrics.
-func (noopMeter) RegisterCallback([]instrument.Asynchronous, func(context.Context)) error {
+func (noopMeter) RegisterCallback([]instrument.Asynchronous, Callback) (Registration, error) {
+	return noopRegistration{}, nil
+}
+
+type noopRegistration struct{}
+
+// Unregister does nothing.
+func (noopRegistration) Unregister() error {
 	return nil
 }
 
@@ -130,6 +137,7 @@ var (
 	_ syncfloat64.Counter            = nonrecordingSyncFloat64Instrument{}
 	_ syncfloat64.UpDownCounter      = nonrecordingSyncFloat64Instrument{}
 	_ syncfloat64.Histogram          = nonrecordingSyncFloat64Instrument{}
+	_ syncfloat64.Gauge              = nonrecordingSyncFloat64Instrument{}
 )
 
 func (n nonrecordingSyncFloat64Instrument) Counter(string, ...instrument.Option) (syncfloat64.Counter, error) {
@@ -144,6 +152,10 @@ func (n nonrecordingSyncFloat64Instrument) Histogram(string, ...instrument.Optio
 	return n, nil
 }
 
+func (n nonrecordingSyncFloat64Instrument) Gauge(string, ...instrument.Option) (syncfloat64.Gauge, error) {
+	return n, nil
+}
+
 func (nonrecordingSyncFloat64Instrument) Add(context.Context, float64, ...attribute.KeyValue) {
 
 }
@@ -152,6 +164,14 @@ func (nonrecordingSyncFloat64Instrument) Record(context.Context, float64, ...att
 
 }
 
+func (nonrecordingSyncFloat64Instrument) AddSet(context.Context, float64, attribute.Set) {
+
+}
+
+func (nonrecordingSyncFloat64Instrument) RecordSet(context.Context, float64, attribute.Set) {
+
+}
+
 type nonrecordingSyncInt64Instrument struct {
 	instrument.Synchronous
 }
@@ -161,6 +181,7 @@ var (
 	_ syncint64.Counter            = nonrecordingSyncInt64Instrument{}
 	_ syncint64.UpDownCounter      = nonrecordingSyncInt64Instrument{}
 	_ syncint64.Histogram          = nonrecordingSyncInt64Instrument{}
+	_ syncint64.Gauge              = nonrecordingSyncInt64Instrument{}
 )
 
 func (n nonrecordingSyncInt64Instrument) Counter(string, ...instrument.Option) (syncint64.Counter, error) {
@@ -175,7 +196,15 @@ func (n nonrecordingSyncInt64Instrument) Histogram(string, ...instrument.Option)
 	return n, nil
 }
 
+func (n nonrecordingSyncInt64Instrument) Gauge(string, ...instrument.Option) (syncint64.Gauge, error) {
+	return n, nil
+}
+
 func (nonrecordingSyncInt64Instrument) Add(context.Context, int64, ...attribute.KeyValue) {
 }
 func (nonrecordingSyncInt64Instrument) Record(context.Context, int64, ...attribute.KeyValue) {
 }
+func (nonrecordingSyncInt64Instrument) AddSet(context.Context, int64, attribute.Set) {
+}
+func (nonrecordingSyncInt64Instrument) RecordSet(context.Context, int64, attribute.Set) {
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric/instrument"
 )
 
 func TestNewNoopMeterProvider(t *testing.T) {
@@ -37,18 +38,28 @@ func TestSyncFloat64(t *testing.T) {
 		inst, err := meter.SyncFloat64().Counter("test instrument")
 		require.NoError(t, err)
 		inst.Add(context.Background(), 1.0, attribute.String("key", "value"))
+		inst.AddSet(context.Background(), 1.0, attribute.NewSet(attribute.String("key", "value")))
 	})
 
 	assert.NotPanics(t, func() {
 		inst, err := meter.SyncFloat64().UpDownCounter("test instrument")
 		require.NoError(t, err)
 		inst.Add(context.Background(), -1.0, attribute.String("key", "value"))
+		inst.AddSet(context.Background(), -1.0, attribute.NewSet(attribute.String("key", "value")))
 	})
 
 	assert.NotPanics(t, func() {
 		inst, err := meter.SyncFloat64().Histogram("test instrument")
 		require.NoError(t, err)
 		inst.Record(context.Background(), 1.0, attribute.String("key", "value"))
+		inst.RecordSet(context.Background(), 1.0, attribute.NewSet(attribute.String("key", "value")))
+	})
+
+	assert.NotPanics(t, func() {
+		inst, err := meter.SyncFloat64().Gauge("test instrument")
+		require.NoError(t, err)
+		inst.Record(context.Background(), 1.0, attribute.String("key", "value"))
+		inst.RecordSet(context.Background(), 1.0, attribute.NewSet(attribute.String("key", "value")))
 	})
 }
 
@@ -58,18 +69,28 @@ func TestSyncInt64(t *testing.T) {
 		inst, err := meter.SyncInt64().Counter("test instrument")
 		require.NoError(t, err)
 		inst.Add(context.Background(), 1, attribute.String("key", "value"))
+		inst.AddSet(context.Background(), 1, attribute.NewSet(attribute.String("key", "value")))
 	})
 
 	assert.NotPanics(t, func() {
 		inst, err := meter.SyncInt64().UpDownCounter("test instrument")
 		require.NoError(t, err)
 		inst.Add(context.Background(), -1, attribute.String("key", "value"))
+		inst.AddSet(context.Background(), -1, attribute.NewSet(attribute.String("key", "value")))
 	})
 
 	assert.NotPanics(t, func() {
 		inst, err := meter.SyncInt64().Histogram("test instrument")
 		require.NoError(t, err)
 		inst.Record(context.Background(), 1, attribute.String("key", "value"))
+		inst.RecordSet(context.Background(), 1, attribute.NewSet(attribute.String("key", "value")))
+	})
+
+	assert.NotPanics(t, func() {
+		inst, err := meter.SyncInt64().Gauge("test instrument")
+		require.NoError(t, err)
+		inst.Record(context.Background(), 1, attribute.String("key", "value"))
+		inst.RecordSet(context.Background(), 1, attribute.NewSet(attribute.String("key", "value")))
 	})
 }
 
@@ -114,3 +135,16 @@ func TestAsyncInt64(t *testing.T) {
 		inst.Observe(context.Background(), 1, attribute.String("key", "value"))
 	})
 }
+
+func TestRegisterCallback(t *testing.T) {
+	meter := NewNoopMeterProvider().Meter("test instrumentation")
+	inst, err := meter.AsyncInt64().Gauge("test instrument")
+	require.NoError(t, err)
+
+	reg, err := meter.RegisterCallback([]instrument.Asynchronous{inst}, func(_ context.Context, o Observer) {
+		o.ObserveInt64(inst, 1)
+	})
+	require.NoError(t, err)
+	assert.NoError(t, reg.Unregister())
+	assert.NoError(t, reg.Unregister(), "Unregister should be idempotent")
+}
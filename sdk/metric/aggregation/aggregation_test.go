@@ -55,6 +55,45 @@ func TestAggregationErr(t *testing.T) {
 			Boundaries: []float64{0, 1, 2, 1, 3, 4},
 		}.Err(), errAgg)
 	})
+
+	t.Run("Base2ExponentialHistogramOperation", func(t *testing.T) {
+		assert.NoError(t, Base2ExponentialHistogram{
+			MaxSize:  160,
+			MaxScale: 20,
+		}.Err())
+
+		assert.NoError(t, Base2ExponentialHistogram{
+			MaxSize:  1,
+			NoMinMax: true,
+		}.Err())
+
+		assert.NoError(t, Base2ExponentialHistogram{
+			MaxSize:  1024,
+			MaxScale: -3,
+		}.Err())
+	})
+
+	t.Run("InvalidBase2ExponentialHistogram", func(t *testing.T) {
+		assert.ErrorIs(t, Base2ExponentialHistogram{
+			MaxSize:  0,
+			MaxScale: 20,
+		}.Err(), errAgg)
+
+		assert.ErrorIs(t, Base2ExponentialHistogram{
+			MaxSize:  -1,
+			MaxScale: 20,
+		}.Err(), errAgg)
+
+		assert.ErrorIs(t, Base2ExponentialHistogram{
+			MaxSize:  160,
+			MaxScale: 21,
+		}.Err(), errAgg)
+
+		assert.ErrorIs(t, Base2ExponentialHistogram{
+			MaxSize:  160,
+			MaxScale: -11,
+		}.Err(), errAgg)
+	})
 }
 
 func TestExplicitBucketHistogramDeepCopy(t *testing.T) {
@@ -0,0 +1,147 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metric // import "go.opentelemetry.io/otel/sdk/metric"
+
+import (
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// splitResourceMetrics splits rm into batches that each contain at most limit
+// data points. The Resource and Scope grouping of rm is retained in each
+// batch, and the data points of a single Metrics are split across batches
+// when needed. If limit is not positive, rm is returned as the only batch.
+//
+// Aggregations of an unknown type are counted as a single data point and are
+// never split.
+func splitResourceMetrics(rm metricdata.ResourceMetrics, limit int) []metricdata.ResourceMetrics {
+	if limit <= 0 {
+		return []metricdata.ResourceMetrics{rm}
+	}
+
+	var (
+		batches []metricdata.ResourceMetrics
+		current = metricdata.ResourceMetrics{Resource: rm.Resource}
+		// room is the number of data points that can still be added to
+		// current.
+		room = limit
+	)
+	flush := func() {
+		batches = append(batches, current)
+		current = metricdata.ResourceMetrics{Resource: rm.Resource}
+		room = limit
+	}
+
+	for _, sm := range rm.ScopeMetrics {
+		scope := metricdata.ScopeMetrics{Scope: sm.Scope}
+		for _, m := range sm.Metrics {
+			for {
+				if room <= 0 {
+					if len(scope.Metrics) > 0 {
+						current.ScopeMetrics = append(current.ScopeMetrics, scope)
+						scope = metricdata.ScopeMetrics{Scope: sm.Scope}
+					}
+					flush()
+				}
+
+				head, tail, n := splitMetrics(m, room)
+				scope.Metrics = append(scope.Metrics, head)
+				room -= n
+				if tail == nil {
+					break
+				}
+				m = *tail
+			}
+		}
+		if len(scope.Metrics) > 0 {
+			current.ScopeMetrics = append(current.ScopeMetrics, scope)
+		}
+	}
+	if len(current.ScopeMetrics) > 0 || len(batches) == 0 {
+		flush()
+	}
+	return batches
+}
+
+// splitMetrics splits m so the returned head contains at most n data points,
+// with n greater than zero. The number of data points in head is returned as
+// well as the remaining data points in tail. If all data points of m fit in
+// head, tail is nil.
+func splitMetrics(m metricdata.Metrics, n int) (head metricdata.Metrics, tail *metricdata.Metrics, count int) {
+	head, rest := m, m
+	switch a := m.Data.(type) {
+	case metricdata.Gauge[int64]:
+		h, t := a, a
+		h.DataPoints, t.DataPoints, count = splitDataPoints(a.DataPoints, n)
+		head.Data, rest.Data = h, t
+		if t.DataPoints == nil {
+			return head, nil, count
+		}
+	case metricdata.Gauge[float64]:
+		h, t := a, a
+		h.DataPoints, t.DataPoints, count = splitDataPoints(a.DataPoints, n)
+		head.Data, rest.Data = h, t
+		if t.DataPoints == nil {
+			return head, nil, count
+		}
+	case metricdata.Sum[int64]:
+		h, t := a, a
+		h.DataPoints, t.DataPoints, count = splitDataPoints(a.DataPoints, n)
+		head.Data, rest.Data = h, t
+		if t.DataPoints == nil {
+			return head, nil, count
+		}
+	case metricdata.Sum[float64]:
+		h, t := a, a
+		h.DataPoints, t.DataPoints, count = splitDataPoints(a.DataPoints, n)
+		head.Data, rest.Data = h, t
+		if t.DataPoints == nil {
+			return head, nil, count
+		}
+	case metricdata.Histogram:
+		h, t := a, a
+		h.DataPoints, t.DataPoints, count = splitDataPoints(a.DataPoints, n)
+		head.Data, rest.Data = h, t
+		if t.DataPoints == nil {
+			return head, nil, count
+		}
+	case metricdata.ExponentialHistogram:
+		h, t := a, a
+		h.DataPoints, t.DataPoints, count = splitDataPoints(a.DataPoints, n)
+		head.Data, rest.Data = h, t
+		if t.DataPoints == nil {
+			return head, nil, count
+		}
+	case metricdata.Summary:
+		h, t := a, a
+		h.DataPoints, t.DataPoints, count = splitDataPoints(a.DataPoints, n)
+		head.Data, rest.Data = h, t
+		if t.DataPoints == nil {
+			return head, nil, count
+		}
+	default:
+		return m, nil, 1
+	}
+	return head, &rest, count
+}
+
+// splitDataPoints splits dPts into a head of at most n data points and the
+// remaining tail. The tail is nil if all dPts fit in head. The number of data
+// points in head is also returned.
+func splitDataPoints[T any](dPts []T, n int) (head, tail []T, count int) {
+	if len(dPts) <= n {
+		return dPts, nil, len(dPts)
+	}
+	return dPts[:n], dPts[n:], n
+}
@@ -0,0 +1,166 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metric // import "go.opentelemetry.io/otel/sdk/metric"
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+var (
+	batchRes = resource.NewSchemaless(attribute.String("service.name", "batch"))
+
+	scopeA = instrumentation.Scope{Name: "a"}
+	scopeB = instrumentation.Scope{Name: "b"}
+
+	dPtsAlice = metricdata.DataPoint[int64]{Attributes: attribute.NewSet(attribute.String("user", "alice")), Value: 1}
+	dPtsBob   = metricdata.DataPoint[int64]{Attributes: attribute.NewSet(attribute.String("user", "bob")), Value: 2}
+	dPtsCarol = metricdata.DataPoint[int64]{Attributes: attribute.NewSet(attribute.String("user", "carol")), Value: 3}
+)
+
+func sumMetrics(name string, dPts ...metricdata.DataPoint[int64]) metricdata.Metrics {
+	return metricdata.Metrics{
+		Name: name,
+		Data: metricdata.Sum[int64]{
+			DataPoints:  dPts,
+			Temporality: metricdata.CumulativeTemporality,
+			IsMonotonic: true,
+		},
+	}
+}
+
+func gaugeMetrics(name string, dPts ...metricdata.DataPoint[int64]) metricdata.Metrics {
+	return metricdata.Metrics{
+		Name: name,
+		Data: metricdata.Gauge[int64]{DataPoints: dPts},
+	}
+}
+
+func TestSplitResourceMetrics(t *testing.T) {
+	rm := metricdata.ResourceMetrics{
+		Resource: batchRes,
+		ScopeMetrics: []metricdata.ScopeMetrics{
+			{
+				Scope: scopeA,
+				Metrics: []metricdata.Metrics{
+					sumMetrics("sum", dPtsAlice, dPtsBob, dPtsCarol),
+					gaugeMetrics("gauge", dPtsAlice),
+				},
+			},
+			{
+				Scope: scopeB,
+				Metrics: []metricdata.Metrics{
+					gaugeMetrics("gauge", dPtsBob, dPtsCarol),
+				},
+			},
+		},
+	}
+
+	testcases := []struct {
+		name  string
+		limit int
+		want  []metricdata.ResourceMetrics
+	}{
+		{
+			name:  "NoLimit",
+			limit: 0,
+			want:  []metricdata.ResourceMetrics{rm},
+		},
+		{
+			name:  "LimitNotReached",
+			limit: 6,
+			want:  []metricdata.ResourceMetrics{rm},
+		},
+		{
+			name:  "SplitMetrics",
+			limit: 2,
+			want: []metricdata.ResourceMetrics{
+				{
+					Resource: batchRes,
+					ScopeMetrics: []metricdata.ScopeMetrics{{
+						Scope:   scopeA,
+						Metrics: []metricdata.Metrics{sumMetrics("sum", dPtsAlice, dPtsBob)},
+					}},
+				},
+				{
+					Resource: batchRes,
+					ScopeMetrics: []metricdata.ScopeMetrics{{
+						Scope: scopeA,
+						Metrics: []metricdata.Metrics{
+							sumMetrics("sum", dPtsCarol),
+							gaugeMetrics("gauge", dPtsAlice),
+						},
+					}},
+				},
+				{
+					Resource: batchRes,
+					ScopeMetrics: []metricdata.ScopeMetrics{{
+						Scope:   scopeB,
+						Metrics: []metricdata.Metrics{gaugeMetrics("gauge", dPtsBob, dPtsCarol)},
+					}},
+				},
+			},
+		},
+		{
+			name:  "SplitScopes",
+			limit: 5,
+			want: []metricdata.ResourceMetrics{
+				{
+					Resource: batchRes,
+					ScopeMetrics: []metricdata.ScopeMetrics{
+						rm.ScopeMetrics[0],
+						{
+							Scope:   scopeB,
+							Metrics: []metricdata.Metrics{gaugeMetrics("gauge", dPtsBob)},
+						},
+					},
+				},
+				{
+					Resource: batchRes,
+					ScopeMetrics: []metricdata.ScopeMetrics{{
+						Scope:   scopeB,
+						Metrics: []metricdata.Metrics{gaugeMetrics("gauge", dPtsCarol)},
+					}},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, splitResourceMetrics(rm, tc.limit))
+		})
+	}
+}
+
+func TestSplitResourceMetricsEmpty(t *testing.T) {
+	rm := metricdata.ResourceMetrics{Resource: batchRes}
+	want := []metricdata.ResourceMetrics{rm}
+	assert.Equal(t, want, splitResourceMetrics(rm, 1))
+
+	// Metrics without data points are retained.
+	rm.ScopeMetrics = []metricdata.ScopeMetrics{{
+		Scope:   scopeA,
+		Metrics: []metricdata.Metrics{sumMetrics("empty")},
+	}}
+	want = []metricdata.ResourceMetrics{rm}
+	assert.Equal(t, want, splitResourceMetrics(rm, 1))
+}
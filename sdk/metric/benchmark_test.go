@@ -16,6 +16,7 @@ package metric // import "go.opentelemetry.io/otel/sdk/metric"
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"go.opentelemetry.io/otel/attribute"
@@ -49,6 +50,52 @@ func BenchmarkCounterAddOneAttr(b *testing.B) {
 	}
 }
 
+func BenchmarkCounterAddSetOneAttr(b *testing.B) {
+	ctx, _, cntr := benchCounter(b)
+	set := attribute.NewSet(attribute.String("K", "V"))
+
+	for i := 0; i < b.N; i++ {
+		cntr.AddSet(ctx, 1, set)
+	}
+}
+
+func BenchmarkCounterAddTenAttrs(b *testing.B) {
+	ctx, _, cntr := benchCounter(b)
+	attrs := tenAttrs()
+
+	for i := 0; i < b.N; i++ {
+		cntr.Add(ctx, 1, attrs...)
+	}
+}
+
+func BenchmarkCounterAddSetTenAttrs(b *testing.B) {
+	ctx, _, cntr := benchCounter(b)
+	set := attribute.NewSet(tenAttrs()...)
+
+	for i := 0; i < b.N; i++ {
+		cntr.AddSet(ctx, 1, set)
+	}
+}
+
+func BenchmarkCounterAddSetTenAttrsParallel(b *testing.B) {
+	ctx, _, cntr := benchCounter(b)
+	set := attribute.NewSet(tenAttrs()...)
+
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			cntr.AddSet(ctx, 1, set)
+		}
+	})
+}
+
+func tenAttrs() []attribute.KeyValue {
+	attrs := make([]attribute.KeyValue, 10)
+	for i := range attrs {
+		attrs[i] = attribute.Int(fmt.Sprintf("K%d", i), i)
+	}
+	return attrs
+}
+
 func BenchmarkCounterAddOneInvalidAttr(b *testing.B) {
 	ctx, _, cntr := benchCounter(b)
 
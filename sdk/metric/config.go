@@ -18,7 +18,9 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
+	"go.opentelemetry.io/otel/sdk/metric/exemplar"
 	"go.opentelemetry.io/otel/sdk/metric/view"
 	"go.opentelemetry.io/otel/sdk/resource"
 )
@@ -27,7 +29,15 @@ import (
 type config struct {
 	res     *resource.Resource
 	readers []Reader
-	views   []view.View
+	// readerViews are the views of each Reader in readers, by index, that
+	// are only applied to that Reader.
+	readerViews    [][]view.View
+	views          []view.View
+	exemplarFilter exemplar.Filter
+	// cardinalityLimit is the maximum number of attribute sets an instrument
+	// aggregates. If not positive, no limit is applied.
+	cardinalityLimit int
+	callbackConf     callbackConfig
 }
 
 // readerSignals returns a force-flush and shutdown function for a
@@ -80,7 +90,10 @@ func unifyShutdown(funcs []func(context.Context) error) func(context.Context) er
 
 // newConfig returns a config configured with options.
 func newConfig(options []Option) config {
-	conf := config{res: resource.Default()}
+	conf := config{
+		res:            resource.Default(),
+		exemplarFilter: envExemplarFilterOr(nil),
+	}
 	for _, o := range options {
 		conf = o.apply(conf)
 	}
@@ -115,19 +128,26 @@ func WithResource(res *resource.Resource) Option {
 
 // WithReader associates Reader r with a MeterProvider.
 //
+// The views passed are only applied to the instruments read by r. They are
+// applied in addition to the views associated with the MeterProvider using
+// WithView, after those views.
+//
 // By default, if this option is not used, the MeterProvider will perform no
 // operations; no data will be exported without a Reader.
-func WithReader(r Reader) Option {
+func WithReader(r Reader, views ...view.View) Option {
 	return optionFunc(func(cfg config) config {
 		if r == nil {
 			return cfg
 		}
 		cfg.readers = append(cfg.readers, r)
+		cfg.readerViews = append(cfg.readerViews, views)
 		return cfg
 	})
 }
 
-// WithView associates views a MeterProvider.
+// WithView associates views a MeterProvider. These views are applied to the
+// instruments read by all Readers of the MeterProvider, use WithReader to
+// associate views with a single Reader.
 //
 // Views are appended to existing ones in a MeterProvider if this option is
 // used multiple times.
@@ -140,3 +160,67 @@ func WithView(views ...view.View) Option {
 		return cfg
 	})
 }
+
+// WithExemplarFilter sets the Filter that determines which measurements made
+// with synchronous instruments are offered to exemplar Reservoirs.
+//
+// If the OTEL_METRICS_EXEMPLAR_FILTER environment variable is set, and this
+// option is not passed, the Filter it names is used. Supported values are
+// "always_on", "always_off", and "trace_based".
+//
+// By default, if the environment variable is not set, and this option is not
+// passed, no exemplars are sampled. Use the exemplar.TraceBasedFilter to
+// sample exemplars from measurements made in the context of a sampled span.
+func WithExemplarFilter(f exemplar.Filter) Option {
+	return optionFunc(func(cfg config) config {
+		cfg.exemplarFilter = f
+		return cfg
+	})
+}
+
+// WithCardinalityLimit sets the maximum number of distinct attribute sets,
+// including the overflow attribute set, each instrument will aggregate during
+// an aggregation cycle. Once the limit is reached, measurements with new
+// attribute sets are aggregated into a single series with the
+// otel.metric.overflow=true attribute. Reaching the limit is reported to the
+// OTel error handler.
+//
+// A view.WithCardinalityLimit option takes precedence over this option for
+// the instruments the view matches.
+//
+// By default, if this option is not used or limit is not positive, no limit
+// is applied.
+func WithCardinalityLimit(limit int) Option {
+	return optionFunc(func(cfg config) config {
+		cfg.cardinalityLimit = limit
+		return cfg
+	})
+}
+
+// WithCallbackTimeout sets the maximum duration each callback registered with
+// a Meter is allowed to run during a collection. The context passed to a
+// callback is canceled once its timeout is exceeded. The collection does not
+// wait for a callback that exceeds its timeout, the observations it made are
+// still collected but any made afterwards are dropped. Exceeding the timeout
+// is reported to the OTel error handler.
+//
+// By default, if this option is not used or d is not positive, callbacks are
+// allowed to run until the context of the collection is done.
+func WithCallbackTimeout(d time.Duration) Option {
+	return optionFunc(func(cfg config) config {
+		cfg.callbackConf.timeout = d
+		return cfg
+	})
+}
+
+// WithCallbackConcurrency sets the maximum number of callbacks registered
+// with a Meter that are run concurrently during a collection.
+//
+// By default, if this option is not used or n is less than two, callbacks are
+// run sequentially.
+func WithCallbackConcurrency(n int) Option {
+	return optionFunc(func(cfg config) config {
+		cfg.callbackConf.concurrency = n
+		return cfg
+	})
+}
@@ -18,14 +18,19 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/go-logr/logr/testr"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/sdk/metric/aggregation"
+	"go.opentelemetry.io/otel/sdk/metric/exemplar"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 	"go.opentelemetry.io/otel/sdk/metric/view"
 	"go.opentelemetry.io/otel/sdk/resource"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type reader struct {
@@ -129,6 +134,14 @@ func TestWithReader(t *testing.T) {
 	c := newConfig([]Option{WithReader(r)})
 	require.Len(t, c.readers, 1)
 	assert.Same(t, r, c.readers[0])
+	require.Len(t, c.readerViews, 1)
+	assert.Empty(t, c.readerViews[0])
+
+	v, err := view.New(view.MatchInstrumentName("a"), view.WithRename("b"))
+	require.NoError(t, err)
+	c = newConfig([]Option{WithReader(r), WithReader(nil, v), WithReader(r, v)})
+	require.Len(t, c.readers, 2)
+	assert.Equal(t, [][]view.View{nil, {v}}, c.readerViews)
 }
 
 func TestWithView(t *testing.T) {
@@ -145,3 +158,67 @@ func TestWithView(t *testing.T) {
 	c := newConfig([]Option{WithView(views...)})
 	assert.Equal(t, views, c.views)
 }
+
+func TestWithExemplarFilter(t *testing.T) {
+	c := newConfig(nil)
+	assert.Nil(t, c.exemplarFilter, "default filter")
+
+	c = newConfig([]Option{WithExemplarFilter(exemplar.AlwaysOnFilter)})
+	require.NotNil(t, c.exemplarFilter)
+	assert.True(t, c.exemplarFilter(context.Background()))
+}
+
+func TestExemplarFilterEnv(t *testing.T) {
+	// Invalid values are logged.
+	otel.SetLogger(testr.New(t))
+
+	sampled := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{0x01},
+		SpanID:     trace.SpanID{0x01},
+		TraceFlags: trace.FlagsSampled,
+	}))
+
+	t.Setenv(envExemplarFilter, "always_on")
+	c := newConfig(nil)
+	require.NotNil(t, c.exemplarFilter)
+	assert.True(t, c.exemplarFilter(context.Background()))
+
+	t.Setenv(envExemplarFilter, "TRACE_BASED")
+	c = newConfig(nil)
+	require.NotNil(t, c.exemplarFilter)
+	assert.False(t, c.exemplarFilter(context.Background()))
+	assert.True(t, c.exemplarFilter(sampled))
+
+	t.Setenv(envExemplarFilter, "always_off")
+	assert.Nil(t, newConfig(nil).exemplarFilter)
+
+	t.Setenv(envExemplarFilter, "invalid")
+	assert.Nil(t, newConfig(nil).exemplarFilter, "invalid value should use default")
+
+	t.Setenv(envExemplarFilter, "always_on")
+	c = newConfig([]Option{WithExemplarFilter(exemplar.AlwaysOffFilter)})
+	require.NotNil(t, c.exemplarFilter)
+	assert.False(t, c.exemplarFilter(context.Background()), "option should take precedence")
+}
+
+func TestWithCardinalityLimit(t *testing.T) {
+	c := newConfig(nil)
+	assert.Equal(t, 0, c.cardinalityLimit, "default limit")
+
+	c = newConfig([]Option{WithCardinalityLimit(10)})
+	assert.Equal(t, 10, c.cardinalityLimit)
+}
+
+func TestWithCallbackTimeout(t *testing.T) {
+	c := newConfig(nil)
+	assert.Zero(t, c.callbackConf.timeout)
+	c = newConfig([]Option{WithCallbackTimeout(time.Second)})
+	assert.Equal(t, time.Second, c.callbackConf.timeout)
+}
+
+func TestWithCallbackConcurrency(t *testing.T) {
+	c := newConfig(nil)
+	assert.Zero(t, c.callbackConf.concurrency)
+	c = newConfig([]Option{WithCallbackConcurrency(4)})
+	assert.Equal(t, 4, c.callbackConf.concurrency)
+}
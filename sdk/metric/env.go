@@ -0,0 +1,87 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metric // import "go.opentelemetry.io/otel/sdk/metric"
+
+import (
+	"errors"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/internal/global"
+	"go.opentelemetry.io/otel/sdk/metric/exemplar"
+)
+
+// Environment variable names.
+const (
+	// envInterval is the time interval (in milliseconds) between the start
+	// of two export attempts (i.e. 60000).
+	envInterval = "OTEL_METRIC_EXPORT_INTERVAL"
+	// envTimeout is the maximum allowed time (in milliseconds) to export
+	// data (i.e. 30000).
+	envTimeout = "OTEL_METRIC_EXPORT_TIMEOUT"
+	// envExemplarFilter is the filter used to offer measurements to
+	// exemplar reservoirs (i.e. trace_based).
+	envExemplarFilter = "OTEL_METRICS_EXEMPLAR_FILTER"
+)
+
+var (
+	errNonPositiveDuration = errors.New("non-positive duration")
+	errUnknownFilter       = errors.New("unknown exemplar filter")
+)
+
+// envDuration returns the value of the environment variable with name key as
+// a duration in milliseconds if it exists and is a positive integer.
+// Otherwise, defaultValue is returned.
+func envDuration(key string, defaultValue time.Duration) time.Duration {
+	v, ok := os.LookupEnv(key)
+	if !ok {
+		return defaultValue
+	}
+	d, err := strconv.Atoi(strings.TrimSpace(v))
+	if err != nil {
+		global.Error(err, "parse duration", "environment variable", key, "value", v)
+		return defaultValue
+	}
+	if d <= 0 {
+		global.Error(errNonPositiveDuration, "parse duration", "environment variable", key, "value", v)
+		return defaultValue
+	}
+	return time.Duration(d) * time.Millisecond
+}
+
+// envExemplarFilterOr returns the exemplar Filter named by the
+// OTEL_METRICS_EXEMPLAR_FILTER environment variable if it exists and is one
+// of "always_on", "always_off", or "trace_based". Otherwise, defaultValue is
+// returned.
+func envExemplarFilterOr(defaultValue exemplar.Filter) exemplar.Filter {
+	v, ok := os.LookupEnv(envExemplarFilter)
+	if !ok {
+		return defaultValue
+	}
+	switch strings.ToLower(strings.TrimSpace(v)) {
+	case "always_on":
+		return exemplar.AlwaysOnFilter
+	case "always_off":
+		// No measurements are offered, do not sample exemplars at all.
+		return nil
+	case "trace_based":
+		return exemplar.TraceBasedFilter
+	default:
+		global.Error(errUnknownFilter, "parse exemplar filter", "environment variable", envExemplarFilter, "value", v)
+		return defaultValue
+	}
+}
@@ -0,0 +1,21 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package exemplar provides types and functionality used to sample
+// measurements as exemplars of the timeseries they are aggregated into.
+//
+// A Filter determines which measurements are offered to a Reservoir, and a
+// Reservoir determines which of the offered measurements are retained as
+// exemplars of an aggregation cycle.
+package exemplar // import "go.opentelemetry.io/otel/sdk/metric/exemplar"
@@ -0,0 +1,38 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exemplar // import "go.opentelemetry.io/otel/sdk/metric/exemplar"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Filter determines if a measurement, made in the passed context, should be
+// offered to a Reservoir.
+type Filter func(context.Context) bool
+
+// AlwaysOnFilter is a Filter that offers all measurements to a Reservoir.
+func AlwaysOnFilter(context.Context) bool { return true }
+
+// AlwaysOffFilter is a Filter that does not offer any measurement to a
+// Reservoir.
+func AlwaysOffFilter(context.Context) bool { return false }
+
+// TraceBasedFilter is a Filter that only offers measurements made in the
+// context of a sampled span to a Reservoir.
+func TraceBasedFilter(ctx context.Context) bool {
+	return trace.SpanContextFromContext(ctx).IsSampled()
+}
@@ -0,0 +1,56 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exemplar
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+func sampledCtx() context.Context {
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{0x01},
+		SpanID:     trace.SpanID{0x01},
+		TraceFlags: trace.FlagsSampled,
+	})
+	return trace.ContextWithSpanContext(context.Background(), sc)
+}
+
+func TestAlwaysOnFilter(t *testing.T) {
+	assert.True(t, AlwaysOnFilter(context.Background()))
+	assert.True(t, AlwaysOnFilter(sampledCtx()))
+}
+
+func TestAlwaysOffFilter(t *testing.T) {
+	assert.False(t, AlwaysOffFilter(context.Background()))
+	assert.False(t, AlwaysOffFilter(sampledCtx()))
+}
+
+func TestTraceBasedFilter(t *testing.T) {
+	assert.False(t, TraceBasedFilter(context.Background()), "no span")
+
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: trace.TraceID{0x01},
+		SpanID:  trace.SpanID{0x01},
+	})
+	ctx := trace.ContextWithSpanContext(context.Background(), sc)
+	assert.False(t, TraceBasedFilter(ctx), "unsampled span")
+
+	assert.True(t, TraceBasedFilter(sampledCtx()), "sampled span")
+}
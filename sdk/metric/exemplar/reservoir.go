@@ -0,0 +1,189 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exemplar // import "go.opentelemetry.io/otel/sdk/metric/exemplar"
+
+import (
+	"context"
+	"math/rand"
+	"sort"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Reservoir holds the sampled exemplars of a single timeseries during an
+// aggregation cycle.
+//
+// Reservoirs do not need to be safe for concurrent use. The SDK serializes
+// all calls made to a Reservoir.
+type Reservoir[N int64 | float64] interface {
+	// Offer accepts the parameters associated with a measurement. The
+	// measurement will be stored as an exemplar if the Reservoir decides to
+	// sample it.
+	//
+	// The ctx is the context the measurement was made in, t is the time of
+	// the measurement, val is the measured value, and attr are the attributes
+	// recorded with the measurement that were filtered out of the timeseries.
+	Offer(ctx context.Context, t time.Time, val N, attr []attribute.KeyValue)
+
+	// Collect returns all the held exemplars in dest and resets the
+	// Reservoir.
+	//
+	// The passed dest slice is reused if it has sufficient capacity.
+	Collect(dest *[]metricdata.Exemplar[N])
+}
+
+// ReservoirProvider creates new Reservoirs for each timeseries.
+type ReservoirProvider interface {
+	// Int64 returns a new Reservoir for int64 measurements.
+	Int64() Reservoir[int64]
+	// Float64 returns a new Reservoir for float64 measurements.
+	Float64() Reservoir[float64]
+}
+
+// FixedSize returns a ReservoirProvider of Reservoirs that each hold at most
+// k exemplars. Measurements offered to these Reservoirs are sampled uniformly
+// at random.
+//
+// If k is not positive, a single exemplar will be held.
+func FixedSize(k int) ReservoirProvider {
+	if k <= 0 {
+		k = 1
+	}
+	return fixedSizeProvider(k)
+}
+
+type fixedSizeProvider int
+
+func (p fixedSizeProvider) Int64() Reservoir[int64] {
+	return newFixedSizeReservoir[int64](int(p))
+}
+
+func (p fixedSizeProvider) Float64() Reservoir[float64] {
+	return newFixedSizeReservoir[float64](int(p))
+}
+
+// Histogram returns a ReservoirProvider of Reservoirs that each hold at most
+// one exemplar per histogram bucket defined by bounds. The bucket of the most
+// recent measurement offered to these Reservoirs will hold that measurement.
+//
+// The bounds are expected to be sorted in increasing order, matching the
+// boundaries of an ExplicitBucketHistogram aggregation.
+func Histogram(bounds []float64) ReservoirProvider {
+	b := make([]float64, len(bounds))
+	copy(b, bounds)
+	sort.Float64s(b)
+	return histogramProvider(b)
+}
+
+type histogramProvider []float64
+
+func (p histogramProvider) Int64() Reservoir[int64] {
+	return newHistogramReservoir[int64](p)
+}
+
+func (p histogramProvider) Float64() Reservoir[float64] {
+	return newHistogramReservoir[float64](p)
+}
+
+// fixedSizeReservoir holds at most k exemplars sampled using the simple
+// algorithm R reservoir sampling.
+type fixedSizeReservoir[N int64 | float64] struct {
+	store []metricdata.Exemplar[N]
+	// count is the number of measurements offered during the current
+	// aggregation cycle.
+	count int64
+	rng   *rand.Rand
+}
+
+func newFixedSizeReservoir[N int64 | float64](k int) *fixedSizeReservoir[N] {
+	return &fixedSizeReservoir[N]{
+		store: make([]metricdata.Exemplar[N], 0, k),
+		rng:   rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+}
+
+func (r *fixedSizeReservoir[N]) Offer(ctx context.Context, t time.Time, val N, attr []attribute.KeyValue) {
+	r.count++
+	if len(r.store) < cap(r.store) {
+		r.store = append(r.store, newExemplar(ctx, t, val, attr))
+		return
+	}
+	if j := r.rng.Int63n(r.count); j < int64(len(r.store)) {
+		r.store[j] = newExemplar(ctx, t, val, attr)
+	}
+}
+
+func (r *fixedSizeReservoir[N]) Collect(dest *[]metricdata.Exemplar[N]) {
+	*dest = append((*dest)[:0], r.store...)
+	r.store = r.store[:0]
+	r.count = 0
+}
+
+// histogramReservoir holds the most recent measurement for each bucket of a
+// histogram.
+type histogramReservoir[N int64 | float64] struct {
+	bounds []float64
+	store  []metricdata.Exemplar[N]
+	// set records if the bucket at the same index in store holds an exemplar.
+	set []bool
+}
+
+func newHistogramReservoir[N int64 | float64](bounds []float64) *histogramReservoir[N] {
+	// The last bucket is the implied +infinity bucket.
+	n := len(bounds) + 1
+	return &histogramReservoir[N]{
+		bounds: bounds,
+		store:  make([]metricdata.Exemplar[N], n),
+		set:    make([]bool, n),
+	}
+}
+
+func (r *histogramReservoir[N]) Offer(ctx context.Context, t time.Time, val N, attr []attribute.KeyValue) {
+	// Buckets are upper inclusive, matching the histogram aggregation.
+	idx := sort.SearchFloat64s(r.bounds, float64(val))
+	r.store[idx] = newExemplar(ctx, t, val, attr)
+	r.set[idx] = true
+}
+
+func (r *histogramReservoir[N]) Collect(dest *[]metricdata.Exemplar[N]) {
+	*dest = (*dest)[:0]
+	for i, ok := range r.set {
+		if !ok {
+			continue
+		}
+		*dest = append(*dest, r.store[i])
+		r.store[i] = metricdata.Exemplar[N]{}
+		r.set[i] = false
+	}
+}
+
+// newExemplar returns an Exemplar for the measurement. If ctx contains a
+// valid span context, the span and trace IDs are recorded in the returned
+// Exemplar.
+func newExemplar[N int64 | float64](ctx context.Context, t time.Time, val N, attr []attribute.KeyValue) metricdata.Exemplar[N] {
+	e := metricdata.Exemplar[N]{
+		FilteredAttributes: attr,
+		Time:               t,
+		Value:              val,
+	}
+	if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+		sID, tID := sc.SpanID(), sc.TraceID()
+		e.SpanID, e.TraceID = sID[:], tID[:]
+	}
+	return e
+}
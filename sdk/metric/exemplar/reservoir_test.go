@@ -0,0 +1,138 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exemplar
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	staticTime = time.Unix(1, 0)
+	alice      = []attribute.KeyValue{attribute.String("user", "alice")}
+)
+
+func TestFixedSizeReservoir(t *testing.T) {
+	t.Run("Int64", testFixedSizeReservoir[int64])
+	t.Run("Float64", testFixedSizeReservoir[float64])
+}
+
+func testFixedSizeReservoir[N int64 | float64](t *testing.T) {
+	r := newReservoir[N](FixedSize(2))
+
+	var dest []metricdata.Exemplar[N]
+	r.Collect(&dest)
+	assert.Len(t, dest, 0, "empty reservoir")
+
+	r.Offer(context.Background(), staticTime, 1, alice)
+	r.Offer(sampledCtx(), staticTime, 2, nil)
+	r.Collect(&dest)
+	assert.Equal(t, []metricdata.Exemplar[N]{
+		{FilteredAttributes: alice, Time: staticTime, Value: 1},
+		{
+			Time:    staticTime,
+			Value:   2,
+			SpanID:  []byte{0x01, 0, 0, 0, 0, 0, 0, 0},
+			TraceID: []byte{0x01, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+		},
+	}, dest)
+
+	r.Collect(&dest)
+	assert.Len(t, dest, 0, "reservoir not reset")
+
+	for i := 0; i < 100; i++ {
+		r.Offer(context.Background(), staticTime, N(i), nil)
+	}
+	r.Collect(&dest)
+	assert.Len(t, dest, 2, "reservoir exceeded size")
+	for _, e := range dest {
+		assert.GreaterOrEqual(t, e.Value, N(0))
+		assert.Less(t, e.Value, N(100))
+	}
+}
+
+func TestFixedSizeNonPositive(t *testing.T) {
+	r := newReservoir[int64](FixedSize(0))
+	r.Offer(context.Background(), staticTime, 1, nil)
+	r.Offer(context.Background(), staticTime, 2, nil)
+
+	var dest []metricdata.Exemplar[int64]
+	r.Collect(&dest)
+	assert.Len(t, dest, 1)
+}
+
+func TestHistogramReservoir(t *testing.T) {
+	t.Run("Int64", testHistogramReservoir[int64])
+	t.Run("Float64", testHistogramReservoir[float64])
+}
+
+func testHistogramReservoir[N int64 | float64](t *testing.T) {
+	r := newReservoir[N](Histogram([]float64{10, 0, 5}))
+
+	var dest []metricdata.Exemplar[N]
+	r.Collect(&dest)
+	assert.Len(t, dest, 0, "empty reservoir")
+
+	ctx := context.Background()
+	r.Offer(ctx, staticTime, 20, nil)
+	r.Offer(ctx, staticTime, 1, nil)
+	r.Offer(ctx, staticTime, 3, alice)
+	r.Offer(ctx, staticTime, 0, nil)
+	r.Collect(&dest)
+	assert.Equal(t, []metricdata.Exemplar[N]{
+		{Time: staticTime, Value: 0},
+		{FilteredAttributes: alice, Time: staticTime, Value: 3},
+		{Time: staticTime, Value: 20},
+	}, dest, "last measurement of each bucket")
+
+	r.Collect(&dest)
+	assert.Len(t, dest, 0, "reservoir not reset")
+}
+
+func TestNewExemplarInvalidSpanContext(t *testing.T) {
+	sc := trace.NewSpanContext(trace.SpanContextConfig{SpanID: trace.SpanID{0x01}})
+	ctx := trace.ContextWithSpanContext(context.Background(), sc)
+	e := newExemplar[int64](ctx, staticTime, 1, nil)
+	assert.Nil(t, e.SpanID)
+	assert.Nil(t, e.TraceID)
+}
+
+func newReservoir[N int64 | float64](p ReservoirProvider) Reservoir[N] {
+	var zero N
+	switch any(zero).(type) {
+	case int64:
+		return any(p.Int64()).(Reservoir[N])
+	default:
+		return any(p.Float64()).(Reservoir[N])
+	}
+}
+
+func BenchmarkFixedSizeReservoirOffer(b *testing.B) {
+	r := newFixedSizeReservoir[int64](4)
+	ctx := sampledCtx()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		r.Offer(ctx, staticTime, int64(n), nil)
+	}
+}
@@ -47,6 +47,11 @@ type instrumentID struct {
 	Temporality metricdata.Temporality
 	// Number is the number type of the instrument.
 	Number string
+	// Boundaries are the advised bucket boundaries used by the explicit
+	// bucket histogram aggregation of an instrument, formatted so
+	// instrumentID stays comparable. It is empty if no advised boundaries
+	// are used.
+	Boundaries string
 }
 
 type instrumentImpl[N int64 | float64] struct {
@@ -65,17 +70,15 @@ var _ asyncint64.Gauge = &instrumentImpl[int64]{}
 var _ syncfloat64.Counter = &instrumentImpl[float64]{}
 var _ syncfloat64.UpDownCounter = &instrumentImpl[float64]{}
 var _ syncfloat64.Histogram = &instrumentImpl[float64]{}
+var _ syncfloat64.Gauge = &instrumentImpl[float64]{}
 var _ syncint64.Counter = &instrumentImpl[int64]{}
 var _ syncint64.UpDownCounter = &instrumentImpl[int64]{}
 var _ syncint64.Histogram = &instrumentImpl[int64]{}
+var _ syncint64.Gauge = &instrumentImpl[int64]{}
 
 func (i *instrumentImpl[N]) Observe(ctx context.Context, val N, attrs ...attribute.KeyValue) {
 	// Only record a value if this is being called from the MetricProvider.
-	_, ok := ctx.Value(produceKey).(struct{})
-	if !ok {
-		return
-	}
-	i.aggregate(ctx, val, attrs)
+	observe(ctx, func() { i.aggregate(ctx, val, attrs) })
 }
 
 func (i *instrumentImpl[N]) Add(ctx context.Context, val N, attrs ...attribute.KeyValue) {
@@ -86,11 +89,27 @@ func (i *instrumentImpl[N]) Record(ctx context.Context, val N, attrs ...attribut
 	i.aggregate(ctx, val, attrs)
 }
 
+func (i *instrumentImpl[N]) AddSet(ctx context.Context, val N, attrs attribute.Set) {
+	i.aggregateSet(ctx, val, attrs)
+}
+
+func (i *instrumentImpl[N]) RecordSet(ctx context.Context, val N, attrs attribute.Set) {
+	i.aggregateSet(ctx, val, attrs)
+}
+
 func (i *instrumentImpl[N]) aggregate(ctx context.Context, val N, attrs []attribute.KeyValue) {
+	if len(i.aggregators) == 0 {
+		// Do not compute the attribute set of dropped measurements.
+		return
+	}
+	i.aggregateSet(ctx, val, attribute.NewSet(attrs...))
+}
+
+func (i *instrumentImpl[N]) aggregateSet(ctx context.Context, val N, attrs attribute.Set) {
 	if err := ctx.Err(); err != nil {
 		return
 	}
 	for _, agg := range i.aggregators {
-		agg.Aggregate(val, attribute.NewSet(attrs...))
+		agg.Aggregate(ctx, val, attrs)
 	}
 }
@@ -36,6 +36,9 @@ type instProviderKey struct {
 	Unit unit.Unit
 	// Kind is the instrument Kind provided.
 	Kind view.InstrumentKind
+	// Boundaries are the explicit bucket boundaries advised for the
+	// instrument, nil if none were advised.
+	Boundaries []float64
 }
 
 // viewInst returns the instProviderKey as a view Instrument using scope s.
@@ -65,6 +68,7 @@ func (p *instProvider[N]) lookup(kind view.InstrumentKind, name string, opts []i
 		Description: cfg.Description(),
 		Unit:        cfg.Unit(),
 		Kind:        kind,
+		Boundaries:  cfg.ExplicitBucketBoundaries(),
 	}
 
 	aggs, err := p.resolve.Aggregators(key)
@@ -134,6 +138,11 @@ func (p syncInt64Provider) Histogram(name string, opts ...instrument.Option) (sy
 	return p.lookup(view.SyncHistogram, name, opts)
 }
 
+// Gauge creates an instrument for recording the current value.
+func (p syncInt64Provider) Gauge(name string, opts ...instrument.Option) (syncint64.Gauge, error) {
+	return p.lookup(view.SyncGauge, name, opts)
+}
+
 type syncFloat64Provider struct {
 	*instProvider[float64]
 }
@@ -154,3 +163,8 @@ func (p syncFloat64Provider) UpDownCounter(name string, opts ...instrument.Optio
 func (p syncFloat64Provider) Histogram(name string, opts ...instrument.Option) (syncfloat64.Histogram, error) {
 	return p.lookup(view.SyncHistogram, name, opts)
 }
+
+// Gauge creates an instrument for recording the current value.
+func (p syncFloat64Provider) Gauge(name string, opts ...instrument.Option) (syncfloat64.Gauge, error) {
+	return p.lookup(view.SyncGauge, name, opts)
+}
@@ -93,16 +93,35 @@ func (p *syncInt64Provider) Histogram(string, ...instrument.Option) (syncint64.H
 	return hist, nil
 }
 
-// inst is a generalized int64 synchronous counter, up-down counter, and
-// histogram used for demonstration purposes only.
+func (p *syncInt64Provider) Gauge(string, ...instrument.Option) (syncint64.Gauge, error) {
+	// This is an example of how a synchronous int64 provider would create an
+	// aggregator for a new gauge. At this point the provider would determine
+	// the aggregation and temporality to used based on the Reader and View
+	// configuration. Assume here these are determined to be a last-value
+	// aggregation.
+
+	aggregator := NewLastValue[int64]()
+	gauge := inst{aggregateFunc: aggregator.Aggregate}
+
+	p.aggregations = append(p.aggregations, aggregator.Aggregation())
+
+	fmt.Printf("using %T aggregator for gauge\n", aggregator)
+
+	return gauge, nil
+}
+
+// inst is a generalized int64 synchronous counter, up-down counter,
+// histogram, and gauge used for demonstration purposes only.
 type inst struct {
 	instrument.Synchronous
 
-	aggregateFunc func(int64, attribute.Set)
+	aggregateFunc func(context.Context, int64, attribute.Set)
 }
 
 func (inst) Add(context.Context, int64, ...attribute.KeyValue)    {}
 func (inst) Record(context.Context, int64, ...attribute.KeyValue) {}
+func (inst) AddSet(context.Context, int64, attribute.Set)         {}
+func (inst) RecordSet(context.Context, int64, attribute.Set)      {}
 
 func Example() {
 	m := meter{}
@@ -111,9 +130,11 @@ func Example() {
 	_, _ = provider.Counter("counter example")
 	_, _ = provider.UpDownCounter("up-down counter example")
 	_, _ = provider.Histogram("histogram example")
+	_, _ = provider.Gauge("gauge example")
 
 	// Output:
 	// using *internal.cumulativeSum[int64] aggregator for counter
 	// using *internal.lastValue[int64] aggregator for up-down counter
 	// using *internal.deltaHistogram[int64] aggregator for histogram
+	// using *internal.lastValue[int64] aggregator for gauge
 }
@@ -15,6 +15,7 @@
 package internal // import "go.opentelemetry.io/otel/sdk/metric/internal"
 
 import (
+	"context"
 	"strconv"
 	"sync"
 	"testing"
@@ -97,7 +98,7 @@ func (at *aggregatorTester[N]) Run(a Aggregator[N], incr setMap, eFunc expectFun
 						defer wg.Done()
 						for k := 0; k < at.MeasurementN; k++ {
 							for attrs, n := range incr {
-								a.Aggregate(N(n), attrs)
+								a.Aggregate(context.Background(), N(n), attrs)
 							}
 						}
 					}()
@@ -125,18 +126,33 @@ func benchmarkAggregatorN[N int64 | float64](b *testing.B, factory func() Aggreg
 
 		for n := 0; n < b.N; n++ {
 			for _, attr := range attrs {
-				agg.Aggregate(1, attr)
+				agg.Aggregate(context.Background(), 1, attr)
 			}
 		}
 		bmarkResults = agg.Aggregation()
 	})
 
+	b.Run("AggregateParallel", func(b *testing.B) {
+		agg := factory()
+		b.ReportAllocs()
+		b.ResetTimer()
+
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				for _, attr := range attrs {
+					agg.Aggregate(context.Background(), 1, attr)
+				}
+			}
+		})
+		bmarkResults = agg.Aggregation()
+	})
+
 	b.Run("Aggregations", func(b *testing.B) {
 		aggs := make([]Aggregator[N], b.N)
 		for n := range aggs {
 			a := factory()
 			for _, attr := range attrs {
-				a.Aggregate(1, attr)
+				a.Aggregate(context.Background(), 1, attr)
 			}
 			aggs[n] = a
 		}
@@ -0,0 +1,136 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal // import "go.opentelemetry.io/otel/sdk/metric/internal"
+
+import (
+	"math"
+	"sync/atomic"
+)
+
+// atomicValue is an int64 or float64 value that is safe to update
+// concurrently without locking.
+//
+// An atomicValue must be 64-bit aligned to be used on 32-bit platforms, keep
+// it as the first field of allocated structs.
+type atomicValue[N int64 | float64] struct {
+	// bits are the two's complement representation of an int64 value or
+	// the IEEE 754 binary representation of a float64 value.
+	bits uint64
+}
+
+// add atomically adds value to v.
+func (v *atomicValue[N]) add(value N) {
+	switch n := any(value).(type) {
+	case int64:
+		atomic.AddUint64(&v.bits, uint64(n))
+	case float64:
+		addFloat64(&v.bits, n)
+	}
+}
+
+// store atomically sets v to value.
+func (v *atomicValue[N]) store(value N) {
+	atomic.StoreUint64(&v.bits, toBits(value))
+}
+
+// load atomically loads the value of v.
+func (v *atomicValue[N]) load() N {
+	return fromBits[N](atomic.LoadUint64(&v.bits))
+}
+
+// toBits returns the bits representation of value.
+func toBits[N int64 | float64](value N) uint64 {
+	switch n := any(value).(type) {
+	case int64:
+		return uint64(n)
+	case float64:
+		return math.Float64bits(n)
+	}
+	return 0
+}
+
+// fromBits returns the value represented by bits.
+func fromBits[N int64 | float64](bits uint64) N {
+	var value N
+	switch any(value).(type) {
+	case int64:
+		value = N(int64(bits))
+	case float64:
+		value = N(math.Float64frombits(bits))
+	}
+	return value
+}
+
+// atomicFloat64 is a float64 value that is safe to update concurrently
+// without locking.
+//
+// An atomicFloat64 must be 64-bit aligned to be used on 32-bit platforms,
+// keep it as the first field of allocated structs.
+type atomicFloat64 struct {
+	bits uint64
+}
+
+// add atomically adds value to f.
+func (f *atomicFloat64) add(value float64) {
+	addFloat64(&f.bits, value)
+}
+
+// min atomically sets f to value if value is less than f.
+func (f *atomicFloat64) min(value float64) {
+	for {
+		old := atomic.LoadUint64(&f.bits)
+		if value >= math.Float64frombits(old) {
+			return
+		}
+		if atomic.CompareAndSwapUint64(&f.bits, old, math.Float64bits(value)) {
+			return
+		}
+	}
+}
+
+// max atomically sets f to value if value is greater than f.
+func (f *atomicFloat64) max(value float64) {
+	for {
+		old := atomic.LoadUint64(&f.bits)
+		if value <= math.Float64frombits(old) {
+			return
+		}
+		if atomic.CompareAndSwapUint64(&f.bits, old, math.Float64bits(value)) {
+			return
+		}
+	}
+}
+
+// store atomically sets f to value.
+func (f *atomicFloat64) store(value float64) {
+	atomic.StoreUint64(&f.bits, math.Float64bits(value))
+}
+
+// load atomically loads the value of f.
+func (f *atomicFloat64) load() float64 {
+	return math.Float64frombits(atomic.LoadUint64(&f.bits))
+}
+
+// addFloat64 atomically adds value to the float64 represented by the IEEE 754
+// binary representation stored at addr.
+func addFloat64(addr *uint64, value float64) {
+	for {
+		old := atomic.LoadUint64(addr)
+		sum := math.Float64bits(math.Float64frombits(old) + value)
+		if atomic.CompareAndSwapUint64(addr, old, sum) {
+			return
+		}
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal // import "go.opentelemetry.io/otel/sdk/metric/internal"
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// maxCells is the maximum number of per-CPU cells the storage of an
+// attribute set is split into.
+const maxCells = 64
+
+// numCells is the number of per-CPU cells the storage of an attribute set is
+// split into. It is the smallest power of two that is not less than
+// GOMAXPROCS at initialization, bounded by maxCells.
+var numCells = cellCount(runtime.GOMAXPROCS(0))
+
+// cellCount returns the smallest power of two that is not less than procs,
+// bounded by maxCells.
+func cellCount(procs int) int {
+	n := 1
+	for n < procs && n < maxCells {
+		n <<= 1
+	}
+	return n
+}
+
+// cellHint is the cell index a goroutine aggregates measurements into.
+type cellHint struct {
+	idx uint32
+}
+
+var (
+	// nextCell is the cell index of the next created cellHint.
+	nextCell uint32
+
+	// cellHints hold the cellHints of all Aggregators. A sync.Pool caches
+	// its values per P (the processor running a goroutine). Goroutines
+	// running on the same P most often get the same hint, while goroutines
+	// running in parallel get distinct ones. This approximates per-CPU
+	// storage without needing access to the scheduler.
+	cellHints = sync.Pool{
+		New: func() interface{} {
+			return &cellHint{idx: atomic.AddUint32(&nextCell, 1)}
+		},
+	}
+)
+
+// cellIndex returns the index of the cell, out of n cells, the calling
+// goroutine is to aggregate into. The value n needs to be a power of two.
+func cellIndex(n int) int {
+	if n == 1 {
+		return 0
+	}
+	h := cellHints.Get().(*cellHint)
+	idx := int(h.idx) & (n - 1)
+	cellHints.Put(h)
+	return idx
+}
@@ -0,0 +1,66 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal // import "go.opentelemetry.io/otel/sdk/metric/internal"
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// withCells sets the number of cells newly created Aggregators use to n for
+// the duration of the test.
+func withCells(t *testing.T, n int) {
+	orig := numCells
+	numCells = n
+	t.Cleanup(func() { numCells = orig })
+}
+
+func TestCellCount(t *testing.T) {
+	tests := []struct {
+		procs, want int
+	}{
+		{procs: 0, want: 1},
+		{procs: 1, want: 1},
+		{procs: 2, want: 2},
+		{procs: 3, want: 4},
+		{procs: 8, want: 8},
+		{procs: 12, want: 16},
+		{procs: maxCells, want: maxCells},
+		{procs: 1000, want: maxCells},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.want, cellCount(test.procs), "procs: %d", test.procs)
+	}
+}
+
+func TestCellIndex(t *testing.T) {
+	const n = 8
+
+	var wg sync.WaitGroup
+	for g := 0; g < 10; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				idx := cellIndex(n)
+				assert.GreaterOrEqual(t, idx, 0)
+				assert.Less(t, idx, n)
+			}
+		}()
+	}
+	wg.Wait()
+}
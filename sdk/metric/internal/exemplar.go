@@ -0,0 +1,177 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal // import "go.opentelemetry.io/otel/sdk/metric/internal"
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/exemplar"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// exemplarSampler is an aggregator that samples exemplars of the measurements
+// it aggregates. Sampled exemplars are added to the data points of the
+// backing Aggregator's aggregation they were measured for.
+type exemplarSampler[N int64 | float64] struct {
+	aggregator Aggregator[N]
+	filter     exemplar.Filter
+	newRes     func() exemplar.Reservoir[N]
+
+	// The RWMutex guards reservoirs. Measurements offered to existing
+	// reservoirs only need to acquire the read lock.
+	sync.RWMutex
+	reservoirs map[attribute.Set]*lockedReservoir[N]
+}
+
+// lockedReservoir serializes the calls made to a Reservoir.
+type lockedReservoir[N int64 | float64] struct {
+	sync.Mutex
+	exemplar.Reservoir[N]
+}
+
+// offer offers the measurement to the Reservoir.
+func (r *lockedReservoir[N]) offer(ctx context.Context, t time.Time, val N, attr []attribute.KeyValue) {
+	r.Lock()
+	r.Offer(ctx, t, val, attr)
+	r.Unlock()
+}
+
+// NewExemplarSampler wraps an Aggregator with exemplar sampling.
+// Measurements accepted by filter are offered to a Reservoir created with
+// newRes for the attribute set they are aggregated with.
+//
+// If either filter or newRes is nil, agg is returned unwrapped.
+func NewExemplarSampler[N int64 | float64](agg Aggregator[N], filter exemplar.Filter, newRes func() exemplar.Reservoir[N]) Aggregator[N] {
+	if filter == nil || newRes == nil {
+		return agg
+	}
+	return &exemplarSampler[N]{
+		aggregator: agg,
+		filter:     filter,
+		newRes:     newRes,
+		reservoirs: make(map[attribute.Set]*lockedReservoir[N]),
+	}
+}
+
+// Aggregate records the measurement, scoped by attr, and aggregates it
+// into an aggregation.
+func (s *exemplarSampler[N]) Aggregate(ctx context.Context, measurement N, attr attribute.Set) {
+	s.aggregateFiltered(ctx, measurement, attr, nil)
+}
+
+// aggregateFiltered offers measurement to the reservoir of attr, if it is accepted
+// by the sampler filter, and aggregates the measurement. The dropped
+// attributes are the attributes filtered out of attr for the measurement.
+func (s *exemplarSampler[N]) aggregateFiltered(ctx context.Context, measurement N, attr attribute.Set, dropped []attribute.KeyValue) {
+	if !s.filter(ctx) {
+		s.aggregator.Aggregate(ctx, measurement, attr)
+		return
+	}
+
+	// Offer and aggregate within the same critical section so the exemplar
+	// is collected in the same aggregation cycle as its measurement.
+	t := now()
+	s.RLock()
+	if r, ok := s.reservoirs[attr]; ok {
+		r.offer(ctx, t, measurement, dropped)
+		s.aggregator.Aggregate(ctx, measurement, attr)
+		s.RUnlock()
+		return
+	}
+	s.RUnlock()
+
+	s.Lock()
+	defer s.Unlock()
+	r, ok := s.reservoirs[attr]
+	if !ok {
+		r = &lockedReservoir[N]{Reservoir: s.newRes()}
+		s.reservoirs[attr] = r
+	}
+	r.offer(ctx, t, measurement, dropped)
+	s.aggregator.Aggregate(ctx, measurement, attr)
+}
+
+// Aggregation returns an Aggregation, for all the aggregated
+// measurements made and ends an aggregation cycle.
+//
+// The exemplars sampled during the aggregation cycle are included in the
+// data points of the returned Aggregation.
+func (s *exemplarSampler[N]) Aggregation() metricdata.Aggregation {
+	s.Lock()
+	defer s.Unlock()
+
+	agg := s.aggregator.Aggregation()
+	if len(s.reservoirs) == 0 {
+		return agg
+	}
+
+	switch a := agg.(type) {
+	case metricdata.Sum[N]:
+		for i := range a.DataPoints {
+			s.collect(a.DataPoints[i].Attributes, &a.DataPoints[i].Exemplars)
+		}
+	case metricdata.Gauge[N]:
+		for i := range a.DataPoints {
+			s.collect(a.DataPoints[i].Attributes, &a.DataPoints[i].Exemplars)
+		}
+	case metricdata.Histogram:
+		for i := range a.DataPoints {
+			s.collectFloat64(a.DataPoints[i].Attributes, &a.DataPoints[i].Exemplars)
+		}
+	case metricdata.ExponentialHistogram:
+		for i := range a.DataPoints {
+			s.collectFloat64(a.DataPoints[i].Attributes, &a.DataPoints[i].Exemplars)
+		}
+	}
+	// Exemplars are only reported for the aggregation cycle they were
+	// sampled in.
+	s.reservoirs = make(map[attribute.Set]*lockedReservoir[N])
+	return agg
+}
+
+// collect stores the exemplars sampled for attr in dest.
+func (s *exemplarSampler[N]) collect(attr attribute.Set, dest *[]metricdata.Exemplar[N]) {
+	r, ok := s.reservoirs[attr]
+	if !ok {
+		return
+	}
+	r.Collect(dest)
+	if len(*dest) == 0 {
+		*dest = nil
+	}
+}
+
+// collectFloat64 stores the exemplars sampled for attr in dest, converting
+// their values to float64.
+func (s *exemplarSampler[N]) collectFloat64(attr attribute.Set, dest *[]metricdata.Exemplar[float64]) {
+	var exemplars []metricdata.Exemplar[N]
+	s.collect(attr, &exemplars)
+	if len(exemplars) == 0 {
+		return
+	}
+	*dest = make([]metricdata.Exemplar[float64], len(exemplars))
+	for i, e := range exemplars {
+		(*dest)[i] = metricdata.Exemplar[float64]{
+			FilteredAttributes: e.FilteredAttributes,
+			Time:               e.Time,
+			Value:              float64(e.Value),
+			SpanID:             e.SpanID,
+			TraceID:            e.TraceID,
+		}
+	}
+}
@@ -0,0 +1,261 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal // import "go.opentelemetry.io/otel/sdk/metric/internal"
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/aggregation"
+	"go.opentelemetry.io/otel/sdk/metric/exemplar"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	spanID  = trace.SpanID{0x01}
+	traceID = trace.TraceID{0x01}
+)
+
+func sampledCtx() context.Context {
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled,
+	})
+	return trace.ContextWithSpanContext(context.Background(), sc)
+}
+
+func newRes[N int64 | float64]() exemplar.Reservoir[N] {
+	var zero N
+	switch any(zero).(type) {
+	case int64:
+		return any(exemplar.FixedSize(1).Int64()).(exemplar.Reservoir[N])
+	default:
+		return any(exemplar.FixedSize(1).Float64()).(exemplar.Reservoir[N])
+	}
+}
+
+func testExemplar[N int64 | float64](v N, attr ...attribute.KeyValue) metricdata.Exemplar[N] {
+	return metricdata.Exemplar[N]{
+		FilteredAttributes: attr,
+		Time:               staticTime,
+		Value:              v,
+		SpanID:             spanID[:],
+		TraceID:            traceID[:],
+	}
+}
+
+func TestNewExemplarSamplerNoop(t *testing.T) {
+	agg := NewLastValue[int64]()
+	assert.Equal(t, agg, NewExemplarSampler(agg, nil, newRes[int64]))
+	assert.Equal(t, agg, NewExemplarSampler(agg, exemplar.AlwaysOnFilter, nil))
+	assert.IsType(t, &exemplarSampler[int64]{}, NewExemplarSampler(agg, exemplar.AlwaysOnFilter, newRes[int64]))
+}
+
+func TestExemplarSampler(t *testing.T) {
+	t.Cleanup(mockTime(now))
+
+	t.Run("Int64", testExemplarSampler[int64])
+	t.Run("Float64", testExemplarSampler[float64])
+}
+
+func testExemplarSampler[N int64 | float64](t *testing.T) {
+	t.Run("Sum", func(t *testing.T) {
+		agg := NewExemplarSampler(NewDeltaSum[N](true), exemplar.TraceBasedFilter, newRes[N])
+		agg.Aggregate(sampledCtx(), 2, alice)
+		agg.Aggregate(context.Background(), 3, bob)
+
+		metricdatatest.AssertAggregationsEqual(t, metricdata.Sum[N]{
+			Temporality: metricdata.DeltaTemporality,
+			IsMonotonic: true,
+			DataPoints: []metricdata.DataPoint[N]{
+				{
+					Attributes: alice,
+					StartTime:  staticTime,
+					Time:       staticTime,
+					Value:      2,
+					Exemplars:  []metricdata.Exemplar[N]{testExemplar[N](2)},
+				},
+				{
+					Attributes: bob,
+					StartTime:  staticTime,
+					Time:       staticTime,
+					Value:      3,
+				},
+			},
+		}, agg.Aggregation())
+
+		// Exemplars are only reported for the cycle they were sampled in.
+		agg.Aggregate(context.Background(), 1, alice)
+		metricdatatest.AssertAggregationsEqual(t, metricdata.Sum[N]{
+			Temporality: metricdata.DeltaTemporality,
+			IsMonotonic: true,
+			DataPoints: []metricdata.DataPoint[N]{{
+				Attributes: alice,
+				StartTime:  staticTime,
+				Time:       staticTime,
+				Value:      1,
+			}},
+		}, agg.Aggregation())
+	})
+
+	t.Run("Gauge", func(t *testing.T) {
+		agg := NewExemplarSampler(NewLastValue[N](), exemplar.AlwaysOnFilter, newRes[N])
+		agg.Aggregate(sampledCtx(), 2, alice)
+
+		metricdatatest.AssertAggregationsEqual(t, metricdata.Gauge[N]{
+			DataPoints: []metricdata.DataPoint[N]{{
+				Attributes: alice,
+				Time:       staticTime,
+				Value:      2,
+				Exemplars:  []metricdata.Exemplar[N]{testExemplar[N](2)},
+			}},
+		}, agg.Aggregation())
+	})
+
+	t.Run("Histogram", func(t *testing.T) {
+		cfg := aggregation.ExplicitBucketHistogram{Boundaries: []float64{0, 10}, NoMinMax: true}
+		agg := NewExemplarSampler(NewDeltaHistogram[N](cfg), exemplar.AlwaysOnFilter, newRes[N])
+		agg.Aggregate(sampledCtx(), 2, alice)
+
+		metricdatatest.AssertAggregationsEqual(t, metricdata.Histogram{
+			Temporality: metricdata.DeltaTemporality,
+			DataPoints: []metricdata.HistogramDataPoint{{
+				Attributes:   alice,
+				StartTime:    staticTime,
+				Time:         staticTime,
+				Count:        1,
+				Bounds:       []float64{0, 10},
+				BucketCounts: []uint64{0, 1, 0},
+				Sum:          2,
+				Exemplars:    []metricdata.Exemplar[float64]{testExemplar[float64](2)},
+			}},
+		}, agg.Aggregation())
+	})
+
+	t.Run("ExponentialHistogram", func(t *testing.T) {
+		agg := NewExemplarSampler(NewDeltaExponentialHistogram[N](expoHistConf), exemplar.AlwaysOnFilter, newRes[N])
+		agg.Aggregate(sampledCtx(), 2, alice)
+
+		out := agg.Aggregation()
+		require.IsType(t, metricdata.ExponentialHistogram{}, out)
+		dPts := out.(metricdata.ExponentialHistogram).DataPoints
+		require.Len(t, dPts, 1)
+		assert.Equal(t, []metricdata.Exemplar[float64]{testExemplar[float64](2)}, dPts[0].Exemplars)
+	})
+}
+
+func TestExemplarSamplerFiltered(t *testing.T) {
+	t.Cleanup(mockTime(now))
+
+	sampler := NewExemplarSampler(NewDeltaSum[int64](true), exemplar.AlwaysOnFilter, newRes[int64])
+	agg := NewFilter(sampler, testAttributeFilter)
+	agg.Aggregate(sampledCtx(), 1, attribute.NewSet(
+		attribute.String("foo", "bar"),
+		attribute.Int("power-level", 9001),
+	))
+
+	fAttr := attribute.NewSet(attribute.Int("power-level", 9001))
+	metricdatatest.AssertAggregationsEqual(t, metricdata.Sum[int64]{
+		Temporality: metricdata.DeltaTemporality,
+		IsMonotonic: true,
+		DataPoints: []metricdata.DataPoint[int64]{{
+			Attributes: fAttr,
+			StartTime:  staticTime,
+			Time:       staticTime,
+			Value:      1,
+			Exemplars: []metricdata.Exemplar[int64]{
+				testExemplar[int64](1, attribute.String("foo", "bar")),
+			},
+		}},
+	}, agg.Aggregation())
+}
+
+// countingReservoir is a Reservoir holding a single exemplar with the
+// number of measurements offered as its value.
+type countingReservoir struct {
+	n int64
+}
+
+func (r *countingReservoir) Offer(context.Context, time.Time, int64, []attribute.KeyValue) {
+	r.n++
+}
+
+func (r *countingReservoir) Collect(dest *[]metricdata.Exemplar[int64]) {
+	*dest = append((*dest)[:0], metricdata.Exemplar[int64]{Value: r.n})
+	r.n = 0
+}
+
+func TestExemplarSamplerConcurrentAggregation(t *testing.T) {
+	newCounting := func() exemplar.Reservoir[int64] { return &countingReservoir{} }
+	agg := NewExemplarSampler(NewDeltaSum[int64](true), exemplar.AlwaysOnFilter, newCounting)
+
+	const goroutines, n = 4, 1000
+	var wg sync.WaitGroup
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < n; j++ {
+				agg.Aggregate(sampledCtx(), 1, alice)
+			}
+		}()
+	}
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	var total int64
+	check := func() {
+		out := agg.Aggregation()
+		if out == nil {
+			return
+		}
+		for _, dp := range out.(metricdata.Sum[int64]).DataPoints {
+			total += dp.Value
+			// Every measurement is offered, the exemplar counts the
+			// measurements offered in the cycle they were aggregated in.
+			require.Len(t, dp.Exemplars, 1)
+			require.Equal(t, dp.Value, dp.Exemplars[0].Value, "exemplars collected in another cycle")
+		}
+	}
+	for {
+		select {
+		case <-done:
+			check()
+			assert.Equal(t, int64(goroutines*n), total)
+			return
+		default:
+			check()
+		}
+	}
+}
+
+func BenchmarkExemplarSampler(b *testing.B) {
+	factory := func() Aggregator[int64] {
+		return NewExemplarSampler(NewCumulativeSum[int64](true), exemplar.TraceBasedFilter, newRes[int64])
+	}
+	b.Run("Int64", benchmarkAggregator(factory))
+}
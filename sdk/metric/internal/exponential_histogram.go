@@ -0,0 +1,431 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal // import "go.opentelemetry.io/otel/sdk/metric/internal"
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/aggregation"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+const (
+	expoMaxScale = 20
+	expoMinScale = -10
+)
+
+// errExpoScaleUnderflow is reported when a measurement cannot be recorded
+// without decreasing the scale of an exponential histogram below
+// expoMinScale.
+var errExpoScaleUnderflow = errors.New("exponential histogram scale underflow")
+
+// expoHistogramDataPoint is a single data point in an exponential histogram.
+type expoHistogramDataPoint struct {
+	count    uint64
+	sum      float64
+	min, max float64
+
+	maxSize int
+	scale   int
+
+	posBuckets expoBuckets
+	negBuckets expoBuckets
+	zeroCount  uint64
+}
+
+func newExpoHistogramDataPoint(maxSize, maxScale int) *expoHistogramDataPoint {
+	return &expoHistogramDataPoint{
+		maxSize: maxSize,
+		scale:   maxScale,
+	}
+}
+
+// record adds a new measurement to the histogram. It will rescale the buckets
+// if needed.
+func (p *expoHistogramDataPoint) record(v float64) {
+	p.count++
+	p.sum += v
+	if v < p.min {
+		p.min = v
+	} else if v > p.max {
+		p.max = v
+	}
+
+	absV := math.Abs(v)
+	if absV == 0.0 {
+		p.zeroCount++
+		return
+	}
+
+	bin := p.getBin(absV)
+
+	bucket := &p.posBuckets
+	if v < 0 {
+		bucket = &p.negBuckets
+	}
+
+	// If the new bin would make the counts larger than maxSize, the current
+	// measurements need to be downscaled.
+	if scaleDelta := p.scaleChange(bin, bucket.startBin, len(bucket.counts)); scaleDelta > 0 {
+		if p.scale-scaleDelta < expoMinScale {
+			// With a scale of -10 there are only three buckets for the whole
+			// range of float64 values. This can only happen if there is a max
+			// size of 1.
+			otel.Handle(errExpoScaleUnderflow)
+			return
+		}
+		p.scale -= scaleDelta
+		p.posBuckets.downscale(scaleDelta)
+		p.negBuckets.downscale(scaleDelta)
+
+		bin = p.getBin(absV)
+	}
+
+	bucket.record(bin)
+}
+
+// getBin returns the bin v should be recorded into.
+func (p *expoHistogramDataPoint) getBin(v float64) int {
+	frac, exp := math.Frexp(v)
+	if p.scale <= 0 {
+		// Because of the choice of fraction, exp is always 1 power of two
+		// higher than wanted.
+		correction := 1
+		if frac == .5 {
+			// If v is an exact power of two frac will be .5 and exp will be
+			// one higher than wanted.
+			correction = 2
+		}
+		return (exp - correction) >> (-p.scale)
+	}
+	return exp<<p.scale + int(math.Log(frac)*scaleFactors[p.scale]) - 1
+}
+
+// scaleFactors are constants used in calculating the logarithm index. They
+// are equivalent to 2^index/log(2).
+var scaleFactors = [21]float64{
+	math.Ldexp(math.Log2E, 0),
+	math.Ldexp(math.Log2E, 1),
+	math.Ldexp(math.Log2E, 2),
+	math.Ldexp(math.Log2E, 3),
+	math.Ldexp(math.Log2E, 4),
+	math.Ldexp(math.Log2E, 5),
+	math.Ldexp(math.Log2E, 6),
+	math.Ldexp(math.Log2E, 7),
+	math.Ldexp(math.Log2E, 8),
+	math.Ldexp(math.Log2E, 9),
+	math.Ldexp(math.Log2E, 10),
+	math.Ldexp(math.Log2E, 11),
+	math.Ldexp(math.Log2E, 12),
+	math.Ldexp(math.Log2E, 13),
+	math.Ldexp(math.Log2E, 14),
+	math.Ldexp(math.Log2E, 15),
+	math.Ldexp(math.Log2E, 16),
+	math.Ldexp(math.Log2E, 17),
+	math.Ldexp(math.Log2E, 18),
+	math.Ldexp(math.Log2E, 19),
+	math.Ldexp(math.Log2E, 20),
+}
+
+// scaleChange returns the magnitude of the scale change needed to fit bin in
+// the bucket. If no scale change is needed 0 is returned.
+func (p *expoHistogramDataPoint) scaleChange(bin, startBin, length int) int {
+	if length == 0 {
+		// No need to rescale if there are no buckets.
+		return 0
+	}
+
+	low := startBin
+	high := bin
+	if startBin >= bin {
+		low = bin
+		high = startBin + length - 1
+	}
+
+	count := 0
+	for high-low >= p.maxSize {
+		low = low >> 1
+		high = high >> 1
+		count++
+		if count > expoMaxScale-expoMinScale {
+			return count
+		}
+	}
+	return count
+}
+
+// expoBuckets is a set of buckets in an exponential histogram.
+type expoBuckets struct {
+	startBin int
+	counts   []uint64
+}
+
+// record increments the count for the given bin, and expands the buckets if
+// needed. Size changes must be done before calling this function.
+func (b *expoBuckets) record(bin int) {
+	if len(b.counts) == 0 {
+		b.counts = []uint64{1}
+		b.startBin = bin
+		return
+	}
+
+	endBin := b.startBin + len(b.counts) - 1
+
+	switch {
+	case bin >= b.startBin && bin <= endBin:
+		// The new bin is inside the current range.
+		b.counts[bin-b.startBin]++
+	case bin < b.startBin:
+		// The new bin is before the current start, prepend empty counts.
+		counts := make([]uint64, endBin-bin+1)
+		copy(counts[b.startBin-bin:], b.counts)
+		counts[0] = 1
+		b.counts = counts
+		b.startBin = bin
+	default:
+		// The new bin is after the current end, append empty counts.
+		end := make([]uint64, bin-endBin)
+		b.counts = append(b.counts, end...)
+		b.counts[bin-b.startBin] = 1
+	}
+}
+
+// downscale shrinks a bucket by a factor of 2^delta. It will sum counts into
+// the correct lower resolution bucket.
+func (b *expoBuckets) downscale(delta int) {
+	// Example
+	// delta = 2
+	// Original offset: -6
+	// Counts: [ 3,  1,  2,  3,  4,  5, 6, 7, 8, 9, 10]
+	// bins:    -6  -5, -4, -3, -2, -1, 0, 1, 2, 3, 4
+	// new bins:-2, -2, -1, -1, -1, -1, 0, 0, 0, 0, 1
+	// new Offset: -2
+	// new Counts: [4, 14, 30, 10]
+
+	if len(b.counts) <= 1 || delta < 1 {
+		b.startBin = b.startBin >> delta
+		return
+	}
+
+	steps := 1 << delta
+	offset := b.startBin % steps
+	offset = (offset + steps) % steps // Ensure offset is positive.
+	for i := 1; i < len(b.counts); i++ {
+		idx := i + offset
+		if idx%steps == 0 {
+			b.counts[idx/steps] = b.counts[i]
+			continue
+		}
+		b.counts[idx/steps] += b.counts[i]
+	}
+
+	lastIdx := (len(b.counts) - 1 + offset) / steps
+	b.counts = b.counts[:lastIdx+1]
+	b.startBin = b.startBin >> delta
+}
+
+// expoHistValues summarizes a set of measurements as exponential histograms.
+type expoHistValues[N int64 | float64] struct {
+	maxSize  int
+	maxScale int
+
+	values   map[attribute.Set]*expoHistogramDataPoint
+	valuesMu sync.Mutex
+}
+
+func newExpoHistValues[N int64 | float64](cfg aggregation.Base2ExponentialHistogram) *expoHistValues[N] {
+	return &expoHistValues[N]{
+		maxSize:  int(cfg.MaxSize),
+		maxScale: int(cfg.MaxScale),
+		values:   make(map[attribute.Set]*expoHistogramDataPoint),
+	}
+}
+
+// Aggregate records the measurement value, scoped by attr, and aggregates it
+// into an exponential histogram.
+func (s *expoHistValues[N]) Aggregate(_ context.Context, value N, attr attribute.Set) {
+	// Accept all types to satisfy the Aggregator interface. However, since
+	// the Aggregation produced by this Aggregator is only float64, convert
+	// here to only use this type.
+	v := float64(value)
+	if math.IsInf(v, 0) || math.IsNaN(v) {
+		// Non-finite values cannot be binned.
+		return
+	}
+
+	s.valuesMu.Lock()
+	defer s.valuesMu.Unlock()
+
+	p, ok := s.values[attr]
+	if !ok {
+		p = newExpoHistogramDataPoint(s.maxSize, s.maxScale)
+		// Ensure min and max are recorded values (not zero), for new points.
+		p.min, p.max = v, v
+		s.values[attr] = p
+	}
+	p.record(v)
+}
+
+// NewDeltaExponentialHistogram returns an Aggregator that summarizes a set
+// of measurements as an exponential histogram. Each histogram is scoped by
+// attributes and the aggregation cycle the measurements were made in.
+//
+// Each aggregation cycle is treated independently. When the returned
+// Aggregator's Aggregations method is called it will reset all histogram
+// counts to zero.
+func NewDeltaExponentialHistogram[N int64 | float64](cfg aggregation.Base2ExponentialHistogram) Aggregator[N] {
+	return &deltaExpoHistogram[N]{
+		expoHistValues: newExpoHistValues[N](cfg),
+		noMinMax:       cfg.NoMinMax,
+		start:          now(),
+	}
+}
+
+// deltaExpoHistogram summarizes a set of measurements made in a single
+// aggregation cycle as an exponential histogram.
+type deltaExpoHistogram[N int64 | float64] struct {
+	*expoHistValues[N]
+
+	noMinMax bool
+	start    time.Time
+}
+
+func (s *deltaExpoHistogram[N]) Aggregation() metricdata.Aggregation {
+	s.valuesMu.Lock()
+	defer s.valuesMu.Unlock()
+
+	if len(s.values) == 0 {
+		return nil
+	}
+
+	t := now()
+	h := metricdata.ExponentialHistogram{
+		Temporality: metricdata.DeltaTemporality,
+		DataPoints:  make([]metricdata.ExponentialHistogramDataPoint, 0, len(s.values)),
+	}
+	for a, p := range s.values {
+		ehdp := metricdata.ExponentialHistogramDataPoint{
+			Attributes: a,
+			StartTime:  s.start,
+			Time:       t,
+			Count:      p.count,
+			Sum:        p.sum,
+			Scale:      int32(p.scale),
+			ZeroCount:  p.zeroCount,
+			PositiveBucket: metricdata.ExponentialBucket{
+				Offset: int32(p.posBuckets.startBin),
+				Counts: p.posBuckets.counts,
+			},
+			NegativeBucket: metricdata.ExponentialBucket{
+				Offset: int32(p.negBuckets.startBin),
+				Counts: p.negBuckets.counts,
+			},
+		}
+		if !s.noMinMax {
+			ehdp.Min = &p.min
+			ehdp.Max = &p.max
+		}
+		h.DataPoints = append(h.DataPoints, ehdp)
+
+		// Unused attribute sets do not report.
+		delete(s.values, a)
+	}
+	// The delta collection cycle resets.
+	s.start = t
+	return h
+}
+
+// NewCumulativeExponentialHistogram returns an Aggregator that summarizes a
+// set of measurements as an exponential histogram. Each histogram is scoped
+// by attributes.
+//
+// Each aggregation cycle builds from the previous, the histogram counts are
+// the bucketed counts of all values aggregated since the returned Aggregator
+// was created.
+func NewCumulativeExponentialHistogram[N int64 | float64](cfg aggregation.Base2ExponentialHistogram) Aggregator[N] {
+	return &cumulativeExpoHistogram[N]{
+		expoHistValues: newExpoHistValues[N](cfg),
+		noMinMax:       cfg.NoMinMax,
+		start:          now(),
+	}
+}
+
+// cumulativeExpoHistogram summarizes a set of measurements made over all
+// aggregation cycles as an exponential histogram.
+type cumulativeExpoHistogram[N int64 | float64] struct {
+	*expoHistValues[N]
+
+	noMinMax bool
+	start    time.Time
+}
+
+func (s *cumulativeExpoHistogram[N]) Aggregation() metricdata.Aggregation {
+	s.valuesMu.Lock()
+	defer s.valuesMu.Unlock()
+
+	if len(s.values) == 0 {
+		return nil
+	}
+
+	t := now()
+	h := metricdata.ExponentialHistogram{
+		Temporality: metricdata.CumulativeTemporality,
+		DataPoints:  make([]metricdata.ExponentialHistogramDataPoint, 0, len(s.values)),
+	}
+	for a, p := range s.values {
+		// The ExponentialHistogramDataPoint field values returned need to be
+		// copies of the data point values as we will keep updating them.
+		posCounts := make([]uint64, len(p.posBuckets.counts))
+		copy(posCounts, p.posBuckets.counts)
+		negCounts := make([]uint64, len(p.negBuckets.counts))
+		copy(negCounts, p.negBuckets.counts)
+
+		ehdp := metricdata.ExponentialHistogramDataPoint{
+			Attributes: a,
+			StartTime:  s.start,
+			Time:       t,
+			Count:      p.count,
+			Sum:        p.sum,
+			Scale:      int32(p.scale),
+			ZeroCount:  p.zeroCount,
+			PositiveBucket: metricdata.ExponentialBucket{
+				Offset: int32(p.posBuckets.startBin),
+				Counts: posCounts,
+			},
+			NegativeBucket: metricdata.ExponentialBucket{
+				Offset: int32(p.negBuckets.startBin),
+				Counts: negCounts,
+			},
+		}
+		if !s.noMinMax {
+			// Similar to counts, make a copy.
+			min, max := p.min, p.max
+			ehdp.Min = &min
+			ehdp.Max = &max
+		}
+		h.DataPoints = append(h.DataPoints, ehdp)
+		// TODO (#3006): This will use an unbounded amount of memory if there
+		// are unbounded number of attribute sets being aggregated. Attribute
+		// sets that become "stale" need to be forgotten so this will not
+		// overload the system.
+	}
+	return h
+}
@@ -0,0 +1,321 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal // import "go.opentelemetry.io/otel/sdk/metric/internal"
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/aggregation"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+)
+
+var expoHistConf = aggregation.Base2ExponentialHistogram{
+	MaxSize:  4,
+	MaxScale: 0,
+}
+
+func TestExponentialHistogram(t *testing.T) {
+	t.Cleanup(mockTime(now))
+	t.Run("Int64", testExponentialHistogram[int64])
+	t.Run("Float64", testExponentialHistogram[float64])
+}
+
+func testExponentialHistogram[N int64 | float64](t *testing.T) {
+	tester := &aggregatorTester[N]{
+		GoroutineN:   defaultGoroutines,
+		MeasurementN: defaultMeasurements,
+		CycleN:       defaultCycles,
+	}
+
+	incr := monoIncr
+	eFunc := deltaExpoHistExpecter(incr)
+	t.Run("Delta", tester.Run(NewDeltaExponentialHistogram[N](expoHistConf), incr, eFunc))
+	eFunc = cumuExpoHistExpecter(incr)
+	t.Run("Cumulative", tester.Run(NewCumulativeExponentialHistogram[N](expoHistConf), incr, eFunc))
+}
+
+func deltaExpoHistExpecter(incr setMap) expectFunc {
+	h := metricdata.ExponentialHistogram{Temporality: metricdata.DeltaTemporality}
+	return func(m int) metricdata.Aggregation {
+		h.DataPoints = make([]metricdata.ExponentialHistogramDataPoint, 0, len(incr))
+		for a, v := range incr {
+			h.DataPoints = append(h.DataPoints, ehPoint(a, float64(v), uint64(m)))
+		}
+		return h
+	}
+}
+
+func cumuExpoHistExpecter(incr setMap) expectFunc {
+	var cycle int
+	h := metricdata.ExponentialHistogram{Temporality: metricdata.CumulativeTemporality}
+	return func(m int) metricdata.Aggregation {
+		cycle++
+		h.DataPoints = make([]metricdata.ExponentialHistogramDataPoint, 0, len(incr))
+		for a, v := range incr {
+			h.DataPoints = append(h.DataPoints, ehPoint(a, float64(v), uint64(cycle*m)))
+		}
+		return h
+	}
+}
+
+// ehPoint returns an ExponentialHistogramDataPoint, using a scale of 0, that
+// started and ended now with multi number of measurements values v. It
+// includes a min and max (set to v).
+func ehPoint(a attribute.Set, v float64, multi uint64) metricdata.ExponentialHistogramDataPoint {
+	// At a scale of 0, the bucket index is the ceiling of log2(v) minus 1.
+	idx := int32(math.Ceil(math.Log2(v))) - 1
+	return metricdata.ExponentialHistogramDataPoint{
+		Attributes: a,
+		StartTime:  now(),
+		Time:       now(),
+		Count:      multi,
+		Min:        &v,
+		Max:        &v,
+		Sum:        v * float64(multi),
+		Scale:      0,
+		PositiveBucket: metricdata.ExponentialBucket{
+			Offset: idx,
+			Counts: []uint64{multi},
+		},
+	}
+}
+
+func TestExpoHistogramDataPointGetBin(t *testing.T) {
+	tests := []struct {
+		scale int
+		value float64
+		want  int
+	}{
+		{scale: 0, value: 1, want: -1},
+		{scale: 0, value: 2, want: 0},
+		{scale: 0, value: 3, want: 1},
+		{scale: 0, value: 4, want: 1},
+		{scale: 0, value: 10, want: 3},
+		{scale: 0, value: 0.5, want: -2},
+		{scale: 0, value: 0.3, want: -2},
+		{scale: 0, value: 0.25, want: -3},
+		{scale: 1, value: 1, want: -1},
+		{scale: 1, value: 1.4, want: 0},
+		{scale: 1, value: 2, want: 1},
+		{scale: 1, value: 3, want: 3},
+		{scale: 1, value: 4, want: 3},
+		{scale: 3, value: 2, want: 7},
+		{scale: 3, value: 0.5, want: -9},
+		{scale: -1, value: 1, want: -1},
+		{scale: -1, value: 2, want: 0},
+		{scale: -1, value: 3, want: 0},
+		{scale: -1, value: 4, want: 0},
+		{scale: -1, value: 5, want: 1},
+		{scale: -2, value: 16, want: 0},
+		{scale: -2, value: 17, want: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("scale %d value %g", tt.scale, tt.value), func(t *testing.T) {
+			p := newExpoHistogramDataPoint(160, tt.scale)
+			assert.Equal(t, tt.want, p.getBin(tt.value))
+		})
+	}
+}
+
+func TestExpoBucketsDownscale(t *testing.T) {
+	tests := []struct {
+		name  string
+		b     expoBuckets
+		delta int
+		want  expoBuckets
+	}{
+		{
+			name:  "Empty",
+			b:     expoBuckets{},
+			delta: 3,
+			want:  expoBuckets{},
+		},
+		{
+			name:  "SingleBucket",
+			b:     expoBuckets{startBin: 5, counts: []uint64{1}},
+			delta: 1,
+			want:  expoBuckets{startBin: 2, counts: []uint64{1}},
+		},
+		{
+			name:  "ZeroDelta",
+			b:     expoBuckets{startBin: 5, counts: []uint64{1, 2, 3}},
+			delta: 0,
+			want:  expoBuckets{startBin: 5, counts: []uint64{1, 2, 3}},
+		},
+		{
+			name:  "AlignedOffset",
+			b:     expoBuckets{startBin: 0, counts: []uint64{1, 2, 3, 4}},
+			delta: 1,
+			want:  expoBuckets{startBin: 0, counts: []uint64{3, 7}},
+		},
+		{
+			name:  "UnalignedOffset",
+			b:     expoBuckets{startBin: 1, counts: []uint64{1, 2, 3, 4}},
+			delta: 1,
+			want:  expoBuckets{startBin: 0, counts: []uint64{1, 5, 4}},
+		},
+		{
+			name:  "NegativeOffset",
+			b:     expoBuckets{startBin: -6, counts: []uint64{3, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10}},
+			delta: 2,
+			want:  expoBuckets{startBin: -2, counts: []uint64{4, 14, 30, 10}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.b.downscale(tt.delta)
+			assert.Equal(t, tt.want, tt.b)
+		})
+	}
+}
+
+func TestExpoBucketsRecord(t *testing.T) {
+	b := expoBuckets{}
+
+	b.record(5)
+	assert.Equal(t, expoBuckets{startBin: 5, counts: []uint64{1}}, b)
+
+	b.record(5)
+	assert.Equal(t, expoBuckets{startBin: 5, counts: []uint64{2}}, b)
+
+	b.record(7)
+	assert.Equal(t, expoBuckets{startBin: 5, counts: []uint64{2, 0, 1}}, b)
+
+	b.record(2)
+	assert.Equal(t, expoBuckets{startBin: 2, counts: []uint64{1, 0, 0, 2, 0, 1}}, b)
+
+	b.record(4)
+	assert.Equal(t, expoBuckets{startBin: 2, counts: []uint64{1, 0, 1, 2, 0, 1}}, b)
+}
+
+func TestExpoHistogramDataPointRecord(t *testing.T) {
+	t.Run("Rescale", func(t *testing.T) {
+		p := newExpoHistogramDataPoint(4, 20)
+		p.min, p.max = 4, 4
+		for _, v := range []float64{4, 1, 2} {
+			p.record(v)
+		}
+
+		assert.Equal(t, 0, p.scale)
+		assert.Equal(t, expoBuckets{startBin: -1, counts: []uint64{1, 1, 1}}, p.posBuckets)
+		assert.Equal(t, expoBuckets{}, p.negBuckets)
+		assert.Equal(t, uint64(3), p.count)
+		assert.Equal(t, 7.0, p.sum)
+		assert.Equal(t, 1.0, p.min)
+		assert.Equal(t, 4.0, p.max)
+	})
+
+	t.Run("NegativeAndZero", func(t *testing.T) {
+		p := newExpoHistogramDataPoint(4, 0)
+		for _, v := range []float64{-1, 0, 2, -4, 0} {
+			p.record(v)
+		}
+
+		assert.Equal(t, 0, p.scale)
+		assert.Equal(t, uint64(2), p.zeroCount)
+		assert.Equal(t, expoBuckets{startBin: 0, counts: []uint64{1}}, p.posBuckets)
+		assert.Equal(t, expoBuckets{startBin: -1, counts: []uint64{1, 0, 1}}, p.negBuckets)
+		assert.Equal(t, uint64(5), p.count)
+		assert.Equal(t, -3.0, p.sum)
+	})
+
+	t.Run("SharedScale", func(t *testing.T) {
+		// Rescaling due to the negative buckets needs to also rescale the
+		// positive buckets.
+		p := newExpoHistogramDataPoint(2, 2)
+		for _, v := range []float64{1, -1, -4} {
+			p.record(v)
+		}
+
+		assert.Equal(t, -1, p.scale)
+		assert.Equal(t, expoBuckets{startBin: -1, counts: []uint64{1}}, p.posBuckets)
+		assert.Equal(t, expoBuckets{startBin: -1, counts: []uint64{1, 1}}, p.negBuckets)
+	})
+}
+
+func TestExponentialHistogramNonFinite(t *testing.T) {
+	a := NewDeltaExponentialHistogram[float64](expoHistConf)
+	a.Aggregate(context.Background(), math.Inf(1), alice)
+	a.Aggregate(context.Background(), math.Inf(-1), alice)
+	a.Aggregate(context.Background(), math.NaN(), alice)
+	assert.Nil(t, a.Aggregation())
+}
+
+func TestCumulativeExponentialHistogramImutableCounts(t *testing.T) {
+	a := NewCumulativeExponentialHistogram[int64](expoHistConf)
+	a.Aggregate(context.Background(), 5, alice)
+	a.Aggregate(context.Background(), -5, alice)
+	ehdp := a.Aggregation().(metricdata.ExponentialHistogram).DataPoints[0]
+
+	cumuH := a.(*cumulativeExpoHistogram[int64])
+	require.Equal(t, ehdp.PositiveBucket.Counts, cumuH.values[alice].posBuckets.counts)
+	require.Equal(t, ehdp.NegativeBucket.Counts, cumuH.values[alice].negBuckets.counts)
+
+	ehdp.PositiveBucket.Counts[0] = 10
+	ehdp.NegativeBucket.Counts[0] = 10
+	assert.Equal(t, []uint64{1}, cumuH.values[alice].posBuckets.counts, "modifying the Aggregator bucket counts should not change the Aggregator")
+	assert.Equal(t, []uint64{1}, cumuH.values[alice].negBuckets.counts, "modifying the Aggregator bucket counts should not change the Aggregator")
+}
+
+func TestDeltaExponentialHistogramReset(t *testing.T) {
+	t.Cleanup(mockTime(now))
+
+	a := NewDeltaExponentialHistogram[int64](expoHistConf)
+	assert.Nil(t, a.Aggregation())
+
+	a.Aggregate(context.Background(), 1, alice)
+	expect := metricdata.ExponentialHistogram{Temporality: metricdata.DeltaTemporality}
+	expect.DataPoints = []metricdata.ExponentialHistogramDataPoint{ehPoint(alice, 1, 1)}
+	metricdatatest.AssertAggregationsEqual(t, expect, a.Aggregation())
+
+	// The attr set should be forgotten once Aggregations is called.
+	expect.DataPoints = nil
+	assert.Nil(t, a.Aggregation())
+
+	// Aggregating another set should not affect the original (alice).
+	a.Aggregate(context.Background(), 1, bob)
+	expect.DataPoints = []metricdata.ExponentialHistogramDataPoint{ehPoint(bob, 1, 1)}
+	metricdatatest.AssertAggregationsEqual(t, expect, a.Aggregation())
+}
+
+func TestEmptyExponentialHistogramNilAggregation(t *testing.T) {
+	assert.Nil(t, NewCumulativeExponentialHistogram[int64](expoHistConf).Aggregation())
+	assert.Nil(t, NewCumulativeExponentialHistogram[float64](expoHistConf).Aggregation())
+	assert.Nil(t, NewDeltaExponentialHistogram[int64](expoHistConf).Aggregation())
+	assert.Nil(t, NewDeltaExponentialHistogram[float64](expoHistConf).Aggregation())
+}
+
+func BenchmarkExponentialHistogram(b *testing.B) {
+	b.Run("Int64", benchmarkExponentialHistogram[int64])
+	b.Run("Float64", benchmarkExponentialHistogram[float64])
+}
+
+func benchmarkExponentialHistogram[N int64 | float64](b *testing.B) {
+	cfg := aggregation.Base2ExponentialHistogram{MaxSize: 160, MaxScale: 20}
+	factory := func() Aggregator[N] { return NewDeltaExponentialHistogram[N](cfg) }
+	b.Run("Delta", benchmarkAggregator(factory))
+	factory = func() Aggregator[N] { return NewCumulativeExponentialHistogram[N](cfg) }
+	b.Run("Cumulative", benchmarkAggregator(factory))
+}
@@ -15,50 +15,155 @@
 package internal // import "go.opentelemetry.io/otel/sdk/metric/internal"
 
 import (
+	"context"
 	"sync"
+	"sync/atomic"
 
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 )
 
+// filteredAggregator is an Aggregator that also accepts the attributes an
+// attribute filter removed from a measurement.
+type filteredAggregator[N int64 | float64] interface {
+	// aggregateFiltered records the measurement, scoped by attr, and
+	// aggregates it into an aggregation. The dropped attributes are the
+	// attributes removed from the measurement attributes to produce attr.
+	aggregateFiltered(ctx context.Context, measurement N, attr attribute.Set, dropped []attribute.KeyValue)
+}
+
 // filter is an aggregator that applies attribute filter when Aggregating. filters
 // do not have any backing memory, and must be constructed with a backing Aggregator.
 type filter[N int64 | float64] struct {
 	filter     func(attribute.Set) attribute.Set
 	aggregator Aggregator[N]
+	// fltrAgg is the backing Aggregator if it accepts the attributes
+	// removed by the filter, otherwise it is nil.
+	fltrAgg filteredAggregator[N]
+
+	// The RWMutex guards seen. Measurements for attribute sets already
+	// filtered only need to acquire the read lock.
+	sync.RWMutex
+	// seen caches the filtered attributes of the attribute sets recorded
+	// since the last aggregation cycle.
+	seen map[attribute.Set]*filtered
+}
 
-	sync.Mutex
-	seen map[attribute.Set]attribute.Set
+// filtered is the result of filtering an attribute set.
+type filtered struct {
+	// attr are the attributes that remain after filtering.
+	attr attribute.Set
+	// dropped are the attributes removed by the filter. These are only
+	// kept when the backing Aggregator accepts them.
+	dropped []attribute.KeyValue
+	// recorded is 1 if the attribute set has been recorded during the
+	// current aggregation cycle, otherwise 0. It is updated atomically.
+	recorded uint32
+}
+
+// markRecorded records that the attribute set has been recorded during the
+// current aggregation cycle.
+func (f *filtered) markRecorded() {
+	// Only store if needed, to not invalidate the cache line shared by the
+	// goroutines recording the attribute set.
+	if atomic.LoadUint32(&f.recorded) == 0 {
+		atomic.StoreUint32(&f.recorded, 1)
+	}
 }
 
 // NewFilter wraps an Aggregator with an attribute filtering function.
+//
+// The fn is expected to filter each attribute independently of the others,
+// like the filter of a View. If the backing Aggregator accepts the attributes
+// removed by fn, these are the attributes fn filters out when applied to them
+// alone, attributes fn renames or rewrites are not removed.
 func NewFilter[N int64 | float64](agg Aggregator[N], fn func(attribute.Set) attribute.Set) Aggregator[N] {
 	if fn == nil {
 		return agg
 	}
-	return &filter[N]{
+	f := &filter[N]{
 		filter:     fn,
 		aggregator: agg,
-		seen:       map[attribute.Set]attribute.Set{},
+		seen:       map[attribute.Set]*filtered{},
 	}
+	f.fltrAgg, _ = agg.(filteredAggregator[N])
+	return f
 }
 
 // Aggregate records the measurement, scoped by attr, and aggregates it
 // into an aggregation.
-func (f *filter[N]) Aggregate(measurement N, attr attribute.Set) {
-	// TODO (#3006): drop stale attributes from seen.
-	f.Lock()
-	defer f.Unlock()
+func (f *filter[N]) Aggregate(ctx context.Context, measurement N, attr attribute.Set) {
+	f.RLock()
 	fAttr, ok := f.seen[attr]
+	if ok {
+		// Mark while holding the read lock so the entry cannot be evicted
+		// concurrently by an Aggregation.
+		fAttr.markRecorded()
+	}
+	f.RUnlock()
+
 	if !ok {
-		fAttr = f.filter(attr)
-		f.seen[attr] = fAttr
+		f.Lock()
+		fAttr, ok = f.seen[attr]
+		if !ok {
+			fAttr = &filtered{attr: f.filter(attr)}
+			if f.fltrAgg != nil {
+				// Only determined when used.
+				fAttr.dropped = f.droppedAttrs(attr, fAttr.attr)
+			}
+			f.seen[attr] = fAttr
+		}
+		fAttr.markRecorded()
+		f.Unlock()
 	}
-	f.aggregator.Aggregate(measurement, fAttr)
+
+	if f.fltrAgg != nil {
+		f.fltrAgg.aggregateFiltered(ctx, measurement, fAttr.attr, fAttr.dropped)
+		return
+	}
+	f.aggregator.Aggregate(ctx, measurement, fAttr.attr)
 }
 
 // Aggregation returns an Aggregation, for all the aggregated
 // measurements made and ends an aggregation cycle.
+//
+// Attribute sets not recorded during the ended aggregation cycle are evicted
+// from the filter cache.
 func (f *filter[N]) Aggregation() metricdata.Aggregation {
+	f.Lock()
+	// Copy the recorded entries into a new map instead of deleting stale
+	// ones, Go maps do not release memory when entries are deleted.
+	seen := make(map[attribute.Set]*filtered)
+	for attr, fAttr := range f.seen {
+		if fAttr.recorded != 0 {
+			fAttr.recorded = 0
+			seen[attr] = fAttr
+		}
+	}
+	f.seen = seen
+	f.Unlock()
+
 	return f.aggregator.Aggregation()
 }
+
+// droppedAttrs returns the attributes of orig removed by the filter to
+// produce fltrd.
+func (f *filter[N]) droppedAttrs(orig, fltrd attribute.Set) []attribute.KeyValue {
+	if orig.Equals(&fltrd) {
+		return nil
+	}
+	var dropped []attribute.KeyValue
+	for iter := orig.Iter(); iter.Next(); {
+		kv := iter.Attribute()
+		if v, ok := fltrd.Value(kv.Key); ok && v == kv.Value {
+			// Kept unchanged.
+			continue
+		}
+		// The attribute is either removed or rewritten, apply the filter
+		// to it alone to tell which.
+		if alone := f.filter(attribute.NewSet(kv)); alone.Len() == 0 {
+			dropped = append(dropped, kv)
+		}
+	}
+	return dropped
+}
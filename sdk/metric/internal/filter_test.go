@@ -15,6 +15,8 @@
 package internal // import "go.opentelemetry.io/otel/sdk/metric/internal"
 
 import (
+	"context"
+	"runtime"
 	"sync"
 	"testing"
 
@@ -23,6 +25,7 @@ import (
 
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
 )
 
 // This is an aggregator that has a stable output, used for testing. It does not
@@ -34,7 +37,7 @@ type testStableAggregator[N int64 | float64] struct {
 
 // Aggregate records the measurement, scoped by attr, and aggregates it
 // into an aggregation.
-func (a *testStableAggregator[N]) Aggregate(measurement N, attr attribute.Set) {
+func (a *testStableAggregator[N]) Aggregate(_ context.Context, measurement N, attr attribute.Set) {
 	a.Lock()
 	defer a.Unlock()
 
@@ -150,7 +153,7 @@ func testFilterAggregate[N int64 | float64](t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			f := NewFilter[N](&testStableAggregator[N]{}, testAttributeFilter)
 			for _, set := range tt.inputAttr {
-				f.Aggregate(1, set)
+				f.Aggregate(context.Background(), 1, set)
 			}
 			out := f.Aggregation().(metricdata.Gauge[N])
 			assert.Equal(t, tt.output, out.DataPoints)
@@ -173,14 +176,14 @@ func testFilterConcurrent[N int64 | float64](t *testing.T) {
 	wg.Add(2)
 
 	go func() {
-		f.Aggregate(1, attribute.NewSet(
+		f.Aggregate(context.Background(), 1, attribute.NewSet(
 			attribute.String("foo", "bar"),
 		))
 		wg.Done()
 	}()
 
 	go func() {
-		f.Aggregate(1, attribute.NewSet(
+		f.Aggregate(context.Background(), 1, attribute.NewSet(
 			attribute.Int("power-level", 9001),
 		))
 		wg.Done()
@@ -197,3 +200,154 @@ func TestFilterConcurrent(t *testing.T) {
 		testFilterConcurrent[float64](t)
 	})
 }
+
+// testDroppedAggregator records the attributes removed by a filter.
+type testDroppedAggregator struct {
+	testStableAggregator[int64]
+	dropped []attribute.KeyValue
+}
+
+func (a *testDroppedAggregator) aggregateFiltered(ctx context.Context, measurement int64, attr attribute.Set, dropped []attribute.KeyValue) {
+	a.Lock()
+	a.dropped = append(a.dropped, dropped...)
+	a.Unlock()
+	a.Aggregate(ctx, measurement, attr)
+}
+
+func TestFilterDroppedAttributes(t *testing.T) {
+	// Keeps foo, renames power-level to level, and removes all others.
+	fn := func(input attribute.Set) attribute.Set {
+		var kvs []attribute.KeyValue
+		for iter := input.Iter(); iter.Next(); {
+			kv := iter.Attribute()
+			switch kv.Key {
+			case "foo":
+				kvs = append(kvs, kv)
+			case "power-level":
+				kvs = append(kvs, attribute.KeyValue{Key: "level", Value: kv.Value})
+			}
+		}
+		return attribute.NewSet(kvs...)
+	}
+
+	agg := &testDroppedAggregator{}
+	f := NewFilter[int64](agg, fn)
+	f.Aggregate(context.Background(), 1, attribute.NewSet(
+		attribute.String("foo", "bar"),
+		attribute.Int("power-level", 9001),
+		attribute.Int("version", 1),
+		attribute.Float64("lifeUniverseEverything", 42.0),
+	))
+	assert.ElementsMatch(t, []attribute.KeyValue{
+		attribute.Float64("lifeUniverseEverything", 42.0),
+		attribute.Int("version", 1),
+	}, agg.dropped)
+	require.Len(t, agg.values, 1)
+	want := attribute.NewSet(attribute.String("foo", "bar"), attribute.Int("level", 9001))
+	assert.Equal(t, want.Equivalent(), agg.values[0].Attributes.Equivalent())
+
+	agg.dropped = nil
+	f.Aggregate(context.Background(), 1, attribute.NewSet(attribute.String("foo", "bar")))
+	assert.Empty(t, agg.dropped, "unfiltered attributes dropped")
+}
+
+func TestFilterEvictsStaleAttributes(t *testing.T) {
+	f := NewFilter[int64](NewDeltaSum[int64](true), testAttributeFilter).(*filter[int64])
+
+	ctx := context.Background()
+	fooSet := attribute.NewSet(attribute.String("foo", "bar"))
+	powerSet := attribute.NewSet(attribute.Int("power-level", 9001))
+
+	f.Aggregate(ctx, 1, fooSet)
+	f.Aggregate(ctx, 1, powerSet)
+	assert.Len(t, f.seen, 2)
+
+	_ = f.Aggregation()
+	assert.Len(t, f.seen, 2, "recorded attributes evicted")
+
+	f.Aggregate(ctx, 1, powerSet)
+	_ = f.Aggregation()
+	assert.Len(t, f.seen, 1, "stale attributes not evicted")
+	assert.Contains(t, f.seen, powerSet)
+
+	_ = f.Aggregation()
+	assert.Len(t, f.seen, 0, "stale attributes not evicted")
+
+	// Evicted attributes are filtered again when recorded.
+	f.Aggregate(ctx, 2, fooSet)
+	metricdatatest.AssertAggregationsEqual(t, metricdata.Sum[int64]{
+		Temporality: metricdata.DeltaTemporality,
+		IsMonotonic: true,
+		DataPoints: []metricdata.DataPoint[int64]{
+			{Attributes: *attribute.EmptySet(), Value: 2},
+		},
+	}, f.Aggregation(), metricdatatest.IgnoreTimestamp())
+}
+
+func TestFilterCacheBoundedUnderChurn(t *testing.T) {
+	const setsPerCycle = 100
+
+	f := NewFilter[int64](NewDeltaSum[int64](true), testAttributeFilter).(*filter[int64])
+	ctx := context.Background()
+	for cycle := 0; cycle < 100; cycle++ {
+		for i := 0; i < setsPerCycle; i++ {
+			// Every attribute set is unique, only seen once.
+			f.Aggregate(ctx, 1, attribute.NewSet(
+				attribute.Int("cycle", cycle),
+				attribute.Int("i", i),
+				attribute.Int("power-level", 9001),
+			))
+		}
+		_ = f.Aggregation()
+		assert.LessOrEqual(t, len(f.seen), setsPerCycle, "cycle %d", cycle)
+	}
+	_ = f.Aggregation()
+	assert.Len(t, f.seen, 0)
+}
+
+func TestFilterMemoryFlatUnderChurn(t *testing.T) {
+	const setsPerCycle = 500
+
+	f := NewFilter[int64](NewDeltaSum[int64](true), testAttributeFilter)
+	ctx := context.Background()
+	churn := func(start, cycles int) {
+		for cycle := start; cycle < start+cycles; cycle++ {
+			for i := 0; i < setsPerCycle; i++ {
+				f.Aggregate(ctx, 1, attribute.NewSet(
+					attribute.Int("cycle", cycle),
+					attribute.Int("i", i),
+					attribute.Int("power-level", 9001),
+				))
+			}
+			_ = f.Aggregation()
+		}
+	}
+	heapInUse := func() uint64 {
+		runtime.GC()
+		var m runtime.MemStats
+		runtime.ReadMemStats(&m)
+		return m.HeapInuse
+	}
+
+	// Warm up so the steady state memory is allocated.
+	churn(0, 10)
+	before := heapInUse()
+
+	// Without eviction, these cycles would retain 100,000 attribute sets
+	// and the filtered results, well over 10 MiB.
+	churn(10, 200)
+	after := heapInUse()
+
+	const tolerance = 2 << 20 // 2 MiB
+	if after > before {
+		assert.Lessf(t, after-before, uint64(tolerance), "heap grew from %d to %d bytes", before, after)
+	}
+	runtime.KeepAlive(f)
+}
+
+func BenchmarkFilter(b *testing.B) {
+	factory := func() Aggregator[int64] {
+		return NewFilter(NewCumulativeSum[int64](true), testAttributeFilter)
+	}
+	b.Run("Int64", benchmarkAggregator(factory))
+}
@@ -15,8 +15,10 @@
 package internal // import "go.opentelemetry.io/otel/sdk/metric/internal"
 
 import (
+	"context"
 	"sort"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"go.opentelemetry.io/otel/attribute"
@@ -24,11 +26,15 @@ import (
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 )
 
+// buckets are the bucket counts and summary statistics of a histogram. All
+// fields are updated atomically.
 type buckets struct {
-	counts   []uint64
+	// Keep the 64-bit values first so they are aligned on 32-bit platforms.
 	count    uint64
-	sum      float64
-	min, max float64
+	sum      atomicFloat64
+	min, max atomicFloat64
+
+	counts []uint64
 }
 
 // newBuckets returns buckets with n bins.
@@ -37,23 +43,50 @@ func newBuckets(n int) *buckets {
 }
 
 func (b *buckets) bin(idx int, value float64) {
-	b.counts[idx]++
-	b.count++
-	b.sum += value
-	if value < b.min {
-		b.min = value
-	} else if value > b.max {
-		b.max = value
+	atomic.AddUint64(&b.counts[idx], 1)
+	atomic.AddUint64(&b.count, 1)
+	b.sum.add(value)
+	b.min.min(value)
+	b.max.max(value)
+}
+
+// merge merges the bucket counts and summary statistics of o into b. Both
+// need to have the same number of bins, and o cannot be updated concurrently.
+func (b *buckets) merge(o *buckets) {
+	for i, c := range o.counts {
+		b.counts[i] += c
 	}
+	b.count += o.count
+	b.sum.add(o.sum.load())
+	b.min.min(o.min.load())
+	b.max.max(o.max.load())
+}
+
+// clone returns a copy of b. The buckets b cannot be updated concurrently.
+func (b *buckets) clone() *buckets {
+	c := newBuckets(len(b.counts))
+	copy(c.counts, b.counts)
+	c.count = b.count
+	c.sum.store(b.sum.load())
+	c.min.store(b.min.load())
+	c.max.store(b.max.load())
+	return c
 }
 
 // histValues summarizes a set of measurements as an histValues with
 // explicitly defined buckets.
+//
+// The values map is guarded by valuesMu while the buckets it holds are
+// updated atomically. Measurements for existing attribute sets only need to
+// acquire the read lock, allowing them to be aggregated concurrently. The
+// buckets of each attribute set are split into per-CPU cells so parallel
+// measurements of the same attribute set do not contend on the same
+// counters. The cells are merged when aggregated.
 type histValues[N int64 | float64] struct {
 	bounds []float64
 
-	values   map[attribute.Set]*buckets
-	valuesMu sync.Mutex
+	values   map[attribute.Set]*histCells
+	valuesMu sync.RWMutex
 }
 
 func newHistValues[N int64 | float64](bounds []float64) *histValues[N] {
@@ -66,13 +99,13 @@ func newHistValues[N int64 | float64](bounds []float64) *histValues[N] {
 	sort.Float64s(b)
 	return &histValues[N]{
 		bounds: b,
-		values: make(map[attribute.Set]*buckets),
+		values: make(map[attribute.Set]*histCells),
 	}
 }
 
 // Aggregate records the measurement value, scoped by attr, and aggregates it
 // into a histogram.
-func (s *histValues[N]) Aggregate(value N, attr attribute.Set) {
+func (s *histValues[N]) Aggregate(_ context.Context, value N, attr attribute.Set) {
 	// Accept all types to satisfy the Aggregator interface. However, since
 	// the Aggregation produced by this Aggregator is only float64, convert
 	// here to only use this type.
@@ -85,11 +118,50 @@ func (s *histValues[N]) Aggregate(value N, attr attribute.Set) {
 	// (s.bounds[len(s.bounds)-1], +∞).
 	idx := sort.SearchFloat64s(s.bounds, v)
 
+	s.valuesMu.RLock()
+	c, ok := s.values[attr]
+	if ok {
+		// Bin while holding the read lock so the buckets cannot be collected
+		// and removed concurrently by a delta Aggregation.
+		c.bin(cellIndex(len(c.cells)), len(s.bounds), idx, v)
+	}
+	s.valuesMu.RUnlock()
+	if ok {
+		return
+	}
+
 	s.valuesMu.Lock()
 	defer s.valuesMu.Unlock()
 
-	b, ok := s.values[attr]
+	// Another goroutine may have added the cells while unlocked.
+	c, ok = s.values[attr]
 	if !ok {
+		c = newHistCells(numCells)
+		s.values[attr] = c
+	}
+	c.bin(cellIndex(len(c.cells)), len(s.bounds), idx, v)
+}
+
+// histCells are the buckets of a single attribute set split into per-CPU
+// cells.
+type histCells struct {
+	// cells hold the *buckets of each cell. The buckets of a cell are only
+	// allocated once a measurement is made on it, attribute sets measured
+	// by a single goroutine at a time only hold one.
+	cells []atomic.Value
+}
+
+// newHistCells returns histCells with n cells.
+func newHistCells(n int) *histCells {
+	return &histCells{cells: make([]atomic.Value, n)}
+}
+
+// bin records v in the bin at idx of the buckets of the cell at index i. The
+// number of bounds of the histogram is n.
+func (c *histCells) bin(i, n, idx int, v float64) {
+	cell := &c.cells[i]
+	b, _ := cell.Load().(*buckets)
+	if b == nil {
 		// N+1 buckets. For example:
 		//
 		//   bounds = [0, 5, 10]
@@ -97,14 +169,38 @@ func (s *histValues[N]) Aggregate(value N, attr attribute.Set) {
 		// Then,
 		//
 		//   buckets = (-∞, 0], (0, 5.0], (5.0, 10.0], (10.0, +∞)
-		b = newBuckets(len(s.bounds) + 1)
+		b = newBuckets(n + 1)
 		// Ensure min and max are recorded values (not zero), for new buckets.
-		b.min, b.max = v, v
-		s.values[attr] = b
+		b.min.store(v)
+		b.max.store(v)
+		if !cell.CompareAndSwap(nil, b) {
+			// Another goroutine allocated the buckets of the cell.
+			b = cell.Load().(*buckets)
+		}
 	}
 	b.bin(idx, v)
 }
 
+// merged returns the buckets of all cells merged. The cells cannot be
+// updated concurrently. If reuse is true, the buckets of a cell may be
+// returned and updated, c cannot be used afterwards.
+func (c *histCells) merged(reuse bool) *buckets {
+	var out *buckets
+	for i := range c.cells {
+		b, _ := c.cells[i].Load().(*buckets)
+		switch {
+		case b == nil:
+		case out != nil:
+			out.merge(b)
+		case reuse:
+			out = b
+		default:
+			out = b.clone()
+		}
+	}
+	return out
+}
+
 // NewDeltaHistogram returns an Aggregator that summarizes a set of
 // measurements as an histogram. Each histogram is scoped by attributes and
 // the aggregation cycle the measurements were made in.
@@ -145,7 +241,8 @@ func (s *deltaHistogram[N]) Aggregation() metricdata.Aggregation {
 		Temporality: metricdata.DeltaTemporality,
 		DataPoints:  make([]metricdata.HistogramDataPoint, 0, len(s.values)),
 	}
-	for a, b := range s.values {
+	for a, c := range s.values {
+		b := c.merged(true)
 		hdp := metricdata.HistogramDataPoint{
 			Attributes:   a,
 			StartTime:    s.start,
@@ -153,11 +250,12 @@ func (s *deltaHistogram[N]) Aggregation() metricdata.Aggregation {
 			Count:        b.count,
 			Bounds:       bounds,
 			BucketCounts: b.counts,
-			Sum:          b.sum,
+			Sum:          b.sum.load(),
 		}
 		if !s.noMinMax {
-			hdp.Min = &b.min
-			hdp.Max = &b.max
+			min, max := b.min.load(), b.max.load()
+			hdp.Min = &min
+			hdp.Max = &max
 		}
 		h.DataPoints = append(h.DataPoints, hdp)
 
@@ -208,14 +306,13 @@ func (s *cumulativeHistogram[N]) Aggregation() metricdata.Aggregation {
 		Temporality: metricdata.CumulativeTemporality,
 		DataPoints:  make([]metricdata.HistogramDataPoint, 0, len(s.values)),
 	}
-	for a, b := range s.values {
-		// The HistogramDataPoint field values returned need to be copies of
-		// the buckets value as we will keep updating them.
+	for a, c := range s.values {
+		// The merged buckets are copies of the ones kept updating, they can
+		// be returned directly.
 		//
 		// TODO (#3047): Making copies for bounds and counts incurs a large
 		// memory allocation footprint. Alternatives should be explored.
-		counts := make([]uint64, len(b.counts))
-		copy(counts, b.counts)
+		b := c.merged(false)
 
 		hdp := metricdata.HistogramDataPoint{
 			Attributes:   a,
@@ -223,12 +320,11 @@ func (s *cumulativeHistogram[N]) Aggregation() metricdata.Aggregation {
 			Time:         t,
 			Count:        b.count,
 			Bounds:       bounds,
-			BucketCounts: counts,
-			Sum:          b.sum,
+			BucketCounts: b.counts,
+			Sum:          b.sum.load(),
 		}
 		if !s.noMinMax {
-			// Similar to counts, make a copy.
-			min, max := b.min, b.max
+			min, max := b.min.load(), b.max.load()
 			hdp.Min = &min
 			hdp.Max = &max
 		}
@@ -15,6 +15,7 @@
 package internal // import "go.opentelemetry.io/otel/sdk/metric/internal"
 
 import (
+	"context"
 	"sort"
 	"testing"
 
@@ -103,9 +104,9 @@ func TestBucketsBin(t *testing.T) {
 	assertB := func(counts []uint64, count uint64, sum, min, max float64) {
 		assert.Equal(t, counts, b.counts)
 		assert.Equal(t, count, b.count)
-		assert.Equal(t, sum, b.sum)
-		assert.Equal(t, min, b.min)
-		assert.Equal(t, max, b.max)
+		assert.Equal(t, sum, b.sum.load())
+		assert.Equal(t, min, b.min.load())
+		assert.Equal(t, max, b.max.load())
 	}
 
 	assertB([]uint64{0, 0, 0}, 0, 0, 0, 0)
@@ -127,7 +128,7 @@ func testHistImmutableBounds[N int64 | float64](newA func(aggregation.ExplicitBu
 		b[0] = 10
 		assert.Equal(t, cpB, getBounds(a), "modifying the bounds argument should not change the bounds")
 
-		a.Aggregate(5, alice)
+		a.Aggregate(context.Background(), 5, alice)
 		hdp := a.Aggregation().(metricdata.Histogram).DataPoints[0]
 		hdp.Bounds[1] = 10
 		assert.Equal(t, cpB, getBounds(a), "modifying the Aggregation bounds should not change the bounds")
@@ -154,16 +155,16 @@ func TestHistogramImmutableBounds(t *testing.T) {
 
 func TestCumulativeHistogramImutableCounts(t *testing.T) {
 	a := NewCumulativeHistogram[int64](histConf)
-	a.Aggregate(5, alice)
+	a.Aggregate(context.Background(), 5, alice)
 	hdp := a.Aggregation().(metricdata.Histogram).DataPoints[0]
 
 	cumuH := a.(*cumulativeHistogram[int64])
-	require.Equal(t, hdp.BucketCounts, cumuH.values[alice].counts)
+	require.Equal(t, hdp.BucketCounts, cumuH.values[alice].merged(false).counts)
 
 	cpCounts := make([]uint64, len(hdp.BucketCounts))
 	copy(cpCounts, hdp.BucketCounts)
 	hdp.BucketCounts[0] = 10
-	assert.Equal(t, cpCounts, cumuH.values[alice].counts, "modifying the Aggregator bucket counts should not change the Aggregator")
+	assert.Equal(t, cpCounts, cumuH.values[alice].merged(false).counts, "modifying the Aggregator bucket counts should not change the Aggregator")
 }
 
 func TestDeltaHistogramReset(t *testing.T) {
@@ -172,7 +173,7 @@ func TestDeltaHistogramReset(t *testing.T) {
 	a := NewDeltaHistogram[int64](histConf)
 	assert.Nil(t, a.Aggregation())
 
-	a.Aggregate(1, alice)
+	a.Aggregate(context.Background(), 1, alice)
 	expect := metricdata.Histogram{Temporality: metricdata.DeltaTemporality}
 	expect.DataPoints = []metricdata.HistogramDataPoint{hPoint(alice, 1, 1)}
 	metricdatatest.AssertAggregationsEqual(t, expect, a.Aggregation())
@@ -182,11 +183,55 @@ func TestDeltaHistogramReset(t *testing.T) {
 	assert.Nil(t, a.Aggregation())
 
 	// Aggregating another set should not affect the original (alice).
-	a.Aggregate(1, bob)
+	a.Aggregate(context.Background(), 1, bob)
 	expect.DataPoints = []metricdata.HistogramDataPoint{hPoint(bob, 1, 1)}
 	metricdatatest.AssertAggregationsEqual(t, expect, a.Aggregation())
 }
 
+func TestHistogramMergesCells(t *testing.T) {
+	t.Cleanup(mockTime(now))
+	withCells(t, 4)
+
+	delta := NewDeltaHistogram[int64](histConf).(*deltaHistogram[int64])
+	cumu := NewCumulativeHistogram[int64](histConf).(*cumulativeHistogram[int64])
+	for _, h := range []*histValues[int64]{delta.histValues, cumu.histValues} {
+		h.Aggregate(context.Background(), 2, alice)
+		h.Aggregate(context.Background(), 10, bob)
+
+		c := h.values[alice]
+		require.Len(t, c.cells, 4)
+		for i := range c.cells {
+			c.bin(i, len(bounds), 1, 2)
+		}
+	}
+
+	want := metricdata.Histogram{
+		Temporality: metricdata.DeltaTemporality,
+		DataPoints:  []metricdata.HistogramDataPoint{hPoint(alice, 2, 5), hPoint(bob, 10, 1)},
+	}
+	metricdatatest.AssertAggregationsEqual(t, want, delta.Aggregation())
+	assert.Nil(t, delta.Aggregation(), "delta cells not reset")
+
+	want.Temporality = metricdata.CumulativeTemporality
+	metricdatatest.AssertAggregationsEqual(t, want, cumu.Aggregation())
+	metricdatatest.AssertAggregationsEqual(t, want, cumu.Aggregation())
+}
+
+func TestHistCellsAllocatedWhenMeasured(t *testing.T) {
+	c := newHistCells(4)
+	assert.Nil(t, c.merged(false), "empty cells merged")
+
+	c.bin(2, len(bounds), 1, 2)
+	for i := range c.cells {
+		b, _ := c.cells[i].Load().(*buckets)
+		if i == 2 {
+			assert.NotNil(t, b, "measured cell not allocated")
+		} else {
+			assert.Nil(t, b, "cell %d allocated without a measurement", i)
+		}
+	}
+}
+
 func TestEmptyHistogramNilAggregation(t *testing.T) {
 	assert.Nil(t, NewCumulativeHistogram[int64](histConf).Aggregation())
 	assert.Nil(t, NewCumulativeHistogram[float64](histConf).Aggregation())
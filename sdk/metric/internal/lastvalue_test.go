@@ -15,6 +15,7 @@
 package internal // import "go.opentelemetry.io/otel/sdk/metric/internal"
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -56,7 +57,7 @@ func testLastValueReset[N int64 | float64](t *testing.T) {
 	a := NewLastValue[N]()
 	assert.Nil(t, a.Aggregation())
 
-	a.Aggregate(1, alice)
+	a.Aggregate(context.Background(), 1, alice)
 	expect := metricdata.Gauge[N]{
 		DataPoints: []metricdata.DataPoint[N]{{
 			Attributes: alice,
@@ -71,7 +72,7 @@ func testLastValueReset[N int64 | float64](t *testing.T) {
 	assert.Nil(t, a.Aggregation())
 
 	// Aggregating another set should not affect the original (alice).
-	a.Aggregate(1, bob)
+	a.Aggregate(context.Background(), 1, bob)
 	expect.DataPoints = []metricdata.DataPoint[N]{{
 		Attributes: bob,
 		Time:       now(),
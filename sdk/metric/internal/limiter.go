@@ -0,0 +1,170 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal // import "go.opentelemetry.io/otel/sdk/metric/internal"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// errCardinalityLimit is returned when the cardinality limit of an aggregation
+// is reached.
+var errCardinalityLimit = errors.New("cardinality limit reached")
+
+// overflowSet is the attribute set measurements are aggregated with once the
+// cardinality limit of an aggregation is reached.
+var overflowSet = attribute.NewSet(attribute.Bool("otel.metric.overflow", true))
+
+// limiter is an aggregator that limits the number of distinct attribute sets
+// aggregated by the backing Aggregator. limiters do not have any backing
+// memory for measurements, and must be constructed with a backing Aggregator.
+type limiter[N int64 | float64] struct {
+	aggregator Aggregator[N]
+	// fltrAgg is the backing Aggregator if it accepts the attributes
+	// removed by a filter, otherwise it is nil.
+	fltrAgg filteredAggregator[N]
+	name    string
+	limit   int
+
+	// The RWMutex guards seen and reported. Measurements for attribute sets
+	// already seen only need to acquire the read lock.
+	sync.RWMutex
+	// seen are the attribute sets the backing Aggregator holds.
+	seen map[attribute.Set]struct{}
+	// reported is true if reaching the limit has been reported.
+	reported bool
+}
+
+// NewLimiter wraps an Aggregator so it aggregates at most limit distinct
+// attribute sets, including the overflow attribute set, during an
+// aggregation cycle. Once the limit is reached, measurements for new
+// attribute sets are aggregated with the overflow attribute set
+// (otel.metric.overflow=true).
+//
+// The name identifies the instrument when the limit is first reached and
+// reported to the OTel error handler.
+//
+// If limit is not positive, agg is returned unwrapped.
+func NewLimiter[N int64 | float64](agg Aggregator[N], name string, limit int) Aggregator[N] {
+	if limit <= 0 {
+		return agg
+	}
+	l := &limiter[N]{
+		aggregator: agg,
+		name:       name,
+		limit:      limit,
+		seen:       make(map[attribute.Set]struct{}),
+	}
+	l.fltrAgg, _ = agg.(filteredAggregator[N])
+	return l
+}
+
+// Aggregate records the measurement, scoped by attr, and aggregates it
+// into an aggregation.
+func (l *limiter[N]) Aggregate(ctx context.Context, measurement N, attr attribute.Set) {
+	l.aggregateFiltered(ctx, measurement, attr, nil)
+}
+
+// aggregateFiltered records the measurement, scoped by attr or the overflow
+// attribute set if the limit has been reached, and aggregates it into an
+// aggregation.
+func (l *limiter[N]) aggregateFiltered(ctx context.Context, measurement N, attr attribute.Set, dropped []attribute.KeyValue) {
+	// The attribute set is decided and the measurement forwarded within the
+	// same critical section. Otherwise an Aggregation could reset the seen
+	// attribute sets in between, and the backing Aggregator would hold an
+	// attribute set not counted against the limit.
+	l.RLock()
+	if _, ok := l.seen[attr]; ok {
+		l.forward(ctx, measurement, attr, dropped)
+		l.RUnlock()
+		return
+	}
+	l.RUnlock()
+
+	l.Lock()
+	defer l.Unlock()
+	l.forward(ctx, measurement, l.attributes(attr), dropped)
+}
+
+// forward aggregates the measurement with the backing Aggregator.
+func (l *limiter[N]) forward(ctx context.Context, measurement N, attr attribute.Set, dropped []attribute.KeyValue) {
+	if l.fltrAgg != nil {
+		l.fltrAgg.aggregateFiltered(ctx, measurement, attr, dropped)
+		return
+	}
+	l.aggregator.Aggregate(ctx, measurement, attr)
+}
+
+// attributes returns attr if it can be aggregated without exceeding the
+// limit, otherwise the overflow attribute set is returned.
+//
+// The l write lock needs to be held when calling this.
+func (l *limiter[N]) attributes(attr attribute.Set) attribute.Set {
+	// Another goroutine may have added attr while unlocked.
+	if _, ok := l.seen[attr]; ok {
+		return attr
+	}
+	// Reserve one attribute set for the overflow attribute set.
+	if len(l.seen) < l.limit-1 {
+		l.seen[attr] = struct{}{}
+		return attr
+	}
+
+	if !l.reported {
+		l.reported = true
+		otel.Handle(fmt.Errorf(
+			"%w: instrument %q reached limit of %d attribute sets, aggregating measurements with %s",
+			errCardinalityLimit, l.name, l.limit, overflowSet.Encoded(attribute.DefaultEncoder()),
+		))
+	}
+	return overflowSet
+}
+
+// Aggregation returns an Aggregation, for all the aggregated
+// measurements made and ends an aggregation cycle.
+func (l *limiter[N]) Aggregation() metricdata.Aggregation {
+	l.Lock()
+	defer l.Unlock()
+
+	agg := l.aggregator.Aggregation()
+	if !isCumulative(agg) {
+		// The backing Aggregator no longer holds any attribute set.
+		l.seen = make(map[attribute.Set]struct{})
+	}
+	return agg
+}
+
+// isCumulative returns if agg reports cumulative temporality. Aggregators
+// producing cumulative aggregations hold all attribute sets across
+// aggregation cycles.
+func isCumulative(agg metricdata.Aggregation) bool {
+	switch a := agg.(type) {
+	case metricdata.Sum[int64]:
+		return a.Temporality == metricdata.CumulativeTemporality
+	case metricdata.Sum[float64]:
+		return a.Temporality == metricdata.CumulativeTemporality
+	case metricdata.Histogram:
+		return a.Temporality == metricdata.CumulativeTemporality
+	case metricdata.ExponentialHistogram:
+		return a.Temporality == metricdata.CumulativeTemporality
+	}
+	return false
+}
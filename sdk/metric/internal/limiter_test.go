@@ -0,0 +1,253 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal // import "go.opentelemetry.io/otel/sdk/metric/internal"
+
+import (
+	"context"
+	"log"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/exemplar"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+)
+
+type errHandler []error
+
+func (h *errHandler) Handle(err error) { *h = append(*h, err) }
+
+// setErrHandler records the errors handled during the test in the returned
+// errHandler. Afterwards they are logged.
+func setErrHandler(t *testing.T) *errHandler {
+	h := &errHandler{}
+	otel.SetErrorHandler(h)
+	t.Cleanup(func() {
+		otel.SetErrorHandler(otel.ErrorHandlerFunc(func(err error) {
+			log.Print(err)
+		}))
+	})
+	return h
+}
+
+func TestNewLimiterNoLimit(t *testing.T) {
+	agg := NewCumulativeSum[int64](true)
+	assert.Equal(t, agg, NewLimiter(agg, "sum", 0))
+	assert.Equal(t, agg, NewLimiter(agg, "sum", -1))
+	assert.IsType(t, &limiter[int64]{}, NewLimiter(agg, "sum", 1))
+}
+
+func TestLimiter(t *testing.T) {
+	t.Cleanup(mockTime(now))
+
+	t.Run("Int64", testLimiter[int64])
+	t.Run("Float64", testLimiter[float64])
+}
+
+func testLimiter[N int64 | float64](t *testing.T) {
+	t.Run("Delta", func(t *testing.T) {
+		errs := setErrHandler(t)
+		agg := NewLimiter(NewDeltaSum[N](true), "sum", 3)
+
+		ctx := context.Background()
+		agg.Aggregate(ctx, 1, alice)
+		agg.Aggregate(ctx, 2, bob)
+		agg.Aggregate(ctx, 3, carol)
+		agg.Aggregate(ctx, 4, alice)
+		agg.Aggregate(ctx, 5, attribute.NewSet(attribute.String("user", "dave")))
+
+		metricdatatest.AssertAggregationsEqual(t, metricdata.Sum[N]{
+			Temporality: metricdata.DeltaTemporality,
+			IsMonotonic: true,
+			DataPoints: []metricdata.DataPoint[N]{
+				{Attributes: alice, StartTime: staticTime, Time: staticTime, Value: 5},
+				{Attributes: bob, StartTime: staticTime, Time: staticTime, Value: 2},
+				{Attributes: overflowSet, StartTime: staticTime, Time: staticTime, Value: 8},
+			},
+		}, agg.Aggregation())
+		require.Len(t, *errs, 1, "limit reached not reported once")
+		assert.ErrorIs(t, (*errs)[0], errCardinalityLimit)
+
+		// Delta aggregation cycles reset the attribute sets.
+		agg.Aggregate(ctx, 3, carol)
+		metricdatatest.AssertAggregationsEqual(t, metricdata.Sum[N]{
+			Temporality: metricdata.DeltaTemporality,
+			IsMonotonic: true,
+			DataPoints: []metricdata.DataPoint[N]{
+				{Attributes: carol, StartTime: staticTime, Time: staticTime, Value: 3},
+			},
+		}, agg.Aggregation())
+	})
+
+	t.Run("Cumulative", func(t *testing.T) {
+		errs := setErrHandler(t)
+		agg := NewLimiter(NewCumulativeSum[N](true), "sum", 2)
+
+		ctx := context.Background()
+		agg.Aggregate(ctx, 1, alice)
+		agg.Aggregate(ctx, 2, bob)
+		metricdatatest.AssertAggregationsEqual(t, metricdata.Sum[N]{
+			Temporality: metricdata.CumulativeTemporality,
+			IsMonotonic: true,
+			DataPoints: []metricdata.DataPoint[N]{
+				{Attributes: alice, StartTime: staticTime, Time: staticTime, Value: 1},
+				{Attributes: overflowSet, StartTime: staticTime, Time: staticTime, Value: 2},
+			},
+		}, agg.Aggregation())
+
+		// Cumulative aggregation cycles keep the attribute sets.
+		agg.Aggregate(ctx, 3, carol)
+		agg.Aggregate(ctx, 4, alice)
+		metricdatatest.AssertAggregationsEqual(t, metricdata.Sum[N]{
+			Temporality: metricdata.CumulativeTemporality,
+			IsMonotonic: true,
+			DataPoints: []metricdata.DataPoint[N]{
+				{Attributes: alice, StartTime: staticTime, Time: staticTime, Value: 5},
+				{Attributes: overflowSet, StartTime: staticTime, Time: staticTime, Value: 5},
+			},
+		}, agg.Aggregation())
+		assert.Len(t, *errs, 1, "limit reached not reported once")
+	})
+
+	t.Run("LastValue", func(t *testing.T) {
+		setErrHandler(t)
+		agg := NewLimiter(NewLastValue[N](), "gauge", 2)
+
+		ctx := context.Background()
+		agg.Aggregate(ctx, 1, alice)
+		agg.Aggregate(ctx, 2, bob)
+		metricdatatest.AssertAggregationsEqual(t, metricdata.Gauge[N]{
+			DataPoints: []metricdata.DataPoint[N]{
+				{Attributes: alice, Time: staticTime, Value: 1},
+				{Attributes: overflowSet, Time: staticTime, Value: 2},
+			},
+		}, agg.Aggregation())
+
+		agg.Aggregate(ctx, 3, bob)
+		metricdatatest.AssertAggregationsEqual(t, metricdata.Gauge[N]{
+			DataPoints: []metricdata.DataPoint[N]{
+				{Attributes: bob, Time: staticTime, Value: 3},
+			},
+		}, agg.Aggregation())
+	})
+}
+
+func TestLimiterFilteredExemplars(t *testing.T) {
+	t.Cleanup(mockTime(now))
+	setErrHandler(t)
+
+	sampler := NewExemplarSampler(NewDeltaSum[int64](true), exemplar.AlwaysOnFilter, newRes[int64])
+	agg := NewFilter(NewLimiter(sampler, "sum", 1), testAttributeFilter)
+	agg.Aggregate(sampledCtx(), 1, attribute.NewSet(
+		attribute.String("foo", "bar"),
+		attribute.Int("power-level", 9001),
+	))
+
+	metricdatatest.AssertAggregationsEqual(t, metricdata.Sum[int64]{
+		Temporality: metricdata.DeltaTemporality,
+		IsMonotonic: true,
+		DataPoints: []metricdata.DataPoint[int64]{{
+			Attributes: overflowSet,
+			StartTime:  staticTime,
+			Time:       staticTime,
+			Value:      1,
+			Exemplars: []metricdata.Exemplar[int64]{
+				testExemplar[int64](1, attribute.String("foo", "bar")),
+			},
+		}},
+	}, agg.Aggregation())
+}
+
+// blockingAggregator blocks its first Aggregate call until release is
+// closed, after closing blocked.
+type blockingAggregator[N int64 | float64] struct {
+	Aggregator[N]
+	once             sync.Once
+	blocked, release chan struct{}
+}
+
+func (a *blockingAggregator[N]) Aggregate(ctx context.Context, measurement N, attr attribute.Set) {
+	a.once.Do(func() {
+		close(a.blocked)
+		<-a.release
+	})
+	a.Aggregator.Aggregate(ctx, measurement, attr)
+}
+
+func TestLimiterConcurrentAggregation(t *testing.T) {
+	setErrHandler(t)
+	const limit = 3
+	backing := &blockingAggregator[int64]{
+		Aggregator: NewDeltaSum[int64](true),
+		blocked:    make(chan struct{}),
+		release:    make(chan struct{}),
+	}
+	agg := NewLimiter[int64](backing, "sum", limit)
+	ctx := context.Background()
+
+	aggregated := make(chan struct{})
+	go func() {
+		defer close(aggregated)
+		agg.Aggregate(ctx, 1, alice)
+	}()
+	<-backing.blocked
+
+	// End the aggregation cycle while alice is being forwarded.
+	var first metricdata.Aggregation
+	collected := make(chan struct{})
+	go func() {
+		defer close(collected)
+		first = agg.Aggregation()
+	}()
+	select {
+	case <-collected:
+	case <-time.After(50 * time.Millisecond):
+		// Waiting for alice to be forwarded.
+	}
+	close(backing.release)
+	<-aggregated
+	<-collected
+
+	agg.Aggregate(ctx, 1, bob)
+	agg.Aggregate(ctx, 1, carol)
+	agg.Aggregate(ctx, 1, attribute.NewSet(attribute.String("user", "dave")))
+	second := agg.Aggregation()
+
+	var total int64
+	for _, out := range []metricdata.Aggregation{first, second} {
+		if out == nil {
+			continue
+		}
+		dPts := out.(metricdata.Sum[int64]).DataPoints
+		assert.LessOrEqual(t, len(dPts), limit, "limit exceeded")
+		for _, dp := range dPts {
+			total += dp.Value
+		}
+	}
+	assert.Equal(t, int64(4), total)
+}
+
+func BenchmarkLimiter(b *testing.B) {
+	factory := func() Aggregator[int64] {
+		return NewLimiter(NewCumulativeSum[int64](true), "sum", 2)
+	}
+	b.Run("Int64", benchmarkAggregator(factory))
+}
@@ -15,6 +15,7 @@
 package internal // import "go.opentelemetry.io/otel/sdk/metric/internal"
 
 import (
+	"context"
 	"sync"
 	"time"
 
@@ -23,24 +24,60 @@ import (
 )
 
 // valueMap is the storage for all sums.
+//
+// The map is guarded by the RWMutex while each value is updated atomically.
+// Measurements for existing attribute sets only need to acquire the read
+// lock, allowing them to be aggregated concurrently.
 type valueMap[N int64 | float64] struct {
-	sync.Mutex
-	values map[attribute.Set]N
+	sync.RWMutex
+	values map[attribute.Set]*atomicValue[N]
 }
 
 func newValueMap[N int64 | float64]() *valueMap[N] {
-	return &valueMap[N]{values: make(map[attribute.Set]N)}
+	return &valueMap[N]{values: make(map[attribute.Set]*atomicValue[N])}
 }
 
 func (s *valueMap[N]) set(value N, attr attribute.Set) { // nolint: unused  // This is indeed used.
+	s.RLock()
+	v, ok := s.values[attr]
+	if ok {
+		v.store(value)
+	}
+	s.RUnlock()
+	if ok {
+		return
+	}
+
 	s.Lock()
-	s.values[attr] = value
+	v, ok = s.values[attr]
+	if !ok {
+		v = new(atomicValue[N])
+		s.values[attr] = v
+	}
+	v.store(value)
 	s.Unlock()
 }
 
-func (s *valueMap[N]) Aggregate(value N, attr attribute.Set) {
+func (s *valueMap[N]) Aggregate(_ context.Context, value N, attr attribute.Set) {
+	s.RLock()
+	v, ok := s.values[attr]
+	if ok {
+		// Add while holding the read lock so the value cannot be collected
+		// and removed concurrently by a delta Aggregation.
+		v.add(value)
+	}
+	s.RUnlock()
+	if ok {
+		return
+	}
+
 	s.Lock()
-	s.values[attr] += value
+	v, ok = s.values[attr]
+	if !ok {
+		v = new(atomicValue[N])
+		s.values[attr] = v
+	}
+	v.add(value)
 	s.Unlock()
 }
 
@@ -94,7 +131,7 @@ func (s *deltaSum[N]) Aggregation() metricdata.Aggregation {
 			Attributes: attr,
 			StartTime:  s.start,
 			Time:       t,
-			Value:      value,
+			Value:      value.load(),
 		})
 		// Unused attribute sets do not report.
 		delete(s.values, attr)
@@ -154,7 +191,7 @@ func (s *cumulativeSum[N]) Aggregation() metricdata.Aggregation {
 			Attributes: attr,
 			StartTime:  s.start,
 			Time:       t,
-			Value:      value,
+			Value:      value.load(),
 		})
 		// TODO (#3006): This will use an unbounded amount of memory if there
 		// are unbounded number of attribute sets being aggregated. Attribute
@@ -168,34 +205,64 @@ func (s *cumulativeSum[N]) Aggregation() metricdata.Aggregation {
 // measurements as their pre-computed arithmetic sum. Each sum is scoped by
 // attributes and the aggregation cycle the measurements were made in.
 //
+// The measurements are expected to be cumulative values, the returned
+// Aggregator converts them to the change since the last aggregation cycle.
+// Attribute sets that are not measured during an aggregation cycle are not
+// reported. Their last measured value is remembered for up to
+// precomputedMaxStale consecutive aggregation cycles, the change of a value
+// measured within these cycles is reported relative to it. After that they
+// are forgotten, and if they are measured in a later cycle, they are reported
+// as if they were measured for the first time.
+//
 // The monotonic value is used to communicate the produced Aggregation is
 // monotonic or not. The returned Aggregator does not make any guarantees this
-// value is accurate. It is up to the caller to ensure it.
+// value is accurate. It is up to the caller to ensure it. If monotonic is
+// true, a measured value lower than the previous one for the same attribute
+// set is considered a reset of its source, and the measured value is reported
+// as the change.
 //
 // The output Aggregation will report recorded values as delta temporality. It
 // is up to the caller to ensure this is accurate.
 func NewPrecomputedDeltaSum[N int64 | float64](monotonic bool) Aggregator[N] {
 	return &precomputedDeltaSum[N]{
 		recorded:  make(map[attribute.Set]N),
-		reported:  make(map[attribute.Set]N),
+		reported:  make(map[attribute.Set]reportedValue[N]),
 		monotonic: monotonic,
 		start:     now(),
 	}
 }
 
+// precomputedMaxStale is the number of consecutive aggregation cycles the
+// last value of an attribute set not measured is remembered for by a
+// precomputedDeltaSum. With the default interval of a PeriodicReader this is
+// five minutes.
+const precomputedMaxStale = 5
+
+// reportedValue is the last cumulative value measured for an attribute set.
+type reportedValue[N int64 | float64] struct {
+	value N
+	// stale is the number of consecutive aggregation cycles the attribute
+	// set has not been measured in since value was measured.
+	stale int
+}
+
 // precomputedDeltaSum summarizes a set of measurements recorded over all
 // aggregation cycles as the delta arithmetic sum.
 type precomputedDeltaSum[N int64 | float64] struct {
 	sync.Mutex
+	// recorded are the cumulative values measured during the current
+	// aggregation cycle.
 	recorded map[attribute.Set]N
-	reported map[attribute.Set]N
+	// reported are the last cumulative values measured in previous
+	// aggregation cycles.
+	reported map[attribute.Set]reportedValue[N]
 
 	monotonic bool
 	start     time.Time
 }
 
 // Aggregate records value as a cumulative sum for attr.
-func (s *precomputedDeltaSum[N]) Aggregate(value N, attr attribute.Set) {
+func (s *precomputedDeltaSum[N]) Aggregate(_ context.Context, value N, attr attribute.Set) {
 	s.Lock()
 	s.recorded[attr] = value
 	s.Unlock()
@@ -205,34 +272,58 @@ func (s *precomputedDeltaSum[N]) Aggregation() metricdata.Aggregation {
 	s.Lock()
 	defer s.Unlock()
 
+	t := now()
+	start := s.start
+	// The delta collection cycle resets.
+	s.start = t
+
+	if len(s.recorded) == 0 && len(s.reported) == 0 {
+		return nil
+	}
+
+	// Swap in a new map instead of deleting stale entries, Go maps do not
+	// release memory when entries are deleted.
+	reported := make(map[attribute.Set]reportedValue[N], len(s.recorded))
+	for attr, r := range s.reported {
+		if _, ok := s.recorded[attr]; ok {
+			continue
+		}
+		// Remember the value in case the attribute set is only missing
+		// from a few aggregation cycles, its next measurement would
+		// otherwise be reported whole as the change.
+		if r.stale++; r.stale <= precomputedMaxStale {
+			reported[attr] = r
+		}
+	}
+
 	if len(s.recorded) == 0 {
+		s.reported = reported
 		return nil
 	}
 
-	t := now()
 	out := metricdata.Sum[N]{
 		Temporality: metricdata.DeltaTemporality,
 		IsMonotonic: s.monotonic,
 		DataPoints:  make([]metricdata.DataPoint[N], 0, len(s.recorded)),
 	}
 	for attr, recorded := range s.recorded {
-		value := recorded - s.reported[attr]
+		prev := s.reported[attr].value
+		value := recorded - prev
+		if s.monotonic && recorded < prev {
+			// The source of the cumulative value was reset. All of the
+			// recorded value accumulated since that reset.
+			value = recorded
+		}
 		out.DataPoints = append(out.DataPoints, metricdata.DataPoint[N]{
 			Attributes: attr,
-			StartTime:  s.start,
+			StartTime:  start,
 			Time:       t,
 			Value:      value,
 		})
-		if value != 0 {
-			s.reported[attr] = recorded
-		}
-		// TODO (#3006): This will use an unbounded amount of memory if there
-		// are unbounded number of attribute sets being aggregated. Attribute
-		// sets that become "stale" need to be forgotten so this will not
-		// overload the system.
+		reported[attr] = reportedValue[N]{value: recorded}
 	}
-	// The delta collection cycle resets.
-	s.start = t
+	s.reported = reported
+	s.recorded = make(map[attribute.Set]N, len(s.recorded))
 	return out
 }
 
@@ -257,6 +348,6 @@ type precomputedSum[N int64 | float64] struct {
 }
 
 // Aggregate records value as a cumulative sum for attr.
-func (s *precomputedSum[N]) Aggregate(value N, attr attribute.Set) {
+func (s *precomputedSum[N]) Aggregate(_ context.Context, value N, attr attribute.Set) {
 	s.set(value, attr)
 }
@@ -15,9 +15,13 @@
 package internal // import "go.opentelemetry.io/otel/sdk/metric/internal"
 
 import (
+	"context"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
@@ -143,7 +147,7 @@ func testDeltaSumReset[N int64 | float64](t *testing.T) {
 	a := NewDeltaSum[N](false)
 	assert.Nil(t, a.Aggregation())
 
-	a.Aggregate(1, alice)
+	a.Aggregate(context.Background(), 1, alice)
 	expect := metricdata.Sum[N]{Temporality: metricdata.DeltaTemporality}
 	expect.DataPoints = []metricdata.DataPoint[N]{point[N](alice, 1)}
 	metricdatatest.AssertAggregationsEqual(t, expect, a.Aggregation())
@@ -153,7 +157,7 @@ func testDeltaSumReset[N int64 | float64](t *testing.T) {
 	assert.Nil(t, a.Aggregation())
 
 	// Aggregating another set should not affect the original (alice).
-	a.Aggregate(1, bob)
+	a.Aggregate(context.Background(), 1, bob)
 	expect.DataPoints = []metricdata.DataPoint[N]{point[N](bob, 1)}
 	metricdatatest.AssertAggregationsEqual(t, expect, a.Aggregation())
 }
@@ -163,6 +167,139 @@ func TestDeltaSumReset(t *testing.T) {
 	t.Run("Float64", testDeltaSumReset[float64])
 }
 
+func TestPrecomputedDeltaSum(t *testing.T) {
+	t.Run("Int64", testPrecomputedDeltaSum[int64])
+	t.Run("Float64", testPrecomputedDeltaSum[float64])
+}
+
+func testPrecomputedDeltaSum[N int64 | float64](t *testing.T) {
+	t.Cleanup(mockTime(now))
+	ctx := context.Background()
+
+	t.Run("Monotonic", func(t *testing.T) {
+		a := NewPrecomputedDeltaSum[N](true)
+		expect := metricdata.Sum[N]{Temporality: metricdata.DeltaTemporality, IsMonotonic: true}
+
+		a.Aggregate(ctx, 3, alice)
+		a.Aggregate(ctx, 2, bob)
+		expect.DataPoints = []metricdata.DataPoint[N]{point[N](alice, 3), point[N](bob, 2)}
+		metricdatatest.AssertAggregationsEqual(t, expect, a.Aggregation())
+
+		// Only the last measurement of a cycle is used.
+		a.Aggregate(ctx, 4, alice)
+		a.Aggregate(ctx, 8, alice)
+		a.Aggregate(ctx, 2, bob)
+		expect.DataPoints = []metricdata.DataPoint[N]{point[N](alice, 5), point[N](bob, 0)}
+		metricdatatest.AssertAggregationsEqual(t, expect, a.Aggregation())
+
+		// A decrease of a monotonic value means its source was reset.
+		a.Aggregate(ctx, 1, alice)
+		a.Aggregate(ctx, 7, bob)
+		expect.DataPoints = []metricdata.DataPoint[N]{point[N](alice, 1), point[N](bob, 5)}
+		metricdatatest.AssertAggregationsEqual(t, expect, a.Aggregation())
+	})
+
+	t.Run("NonMonotonic", func(t *testing.T) {
+		a := NewPrecomputedDeltaSum[N](false)
+		expect := metricdata.Sum[N]{Temporality: metricdata.DeltaTemporality, IsMonotonic: false}
+
+		a.Aggregate(ctx, 3, alice)
+		expect.DataPoints = []metricdata.DataPoint[N]{point[N](alice, 3)}
+		metricdatatest.AssertAggregationsEqual(t, expect, a.Aggregation())
+
+		a.Aggregate(ctx, 1, alice)
+		expect.DataPoints = []metricdata.DataPoint[N]{point[N](alice, -2)}
+		metricdatatest.AssertAggregationsEqual(t, expect, a.Aggregation())
+	})
+
+	t.Run("Disappearing", func(t *testing.T) {
+		a := NewPrecomputedDeltaSum[N](true)
+		expect := metricdata.Sum[N]{Temporality: metricdata.DeltaTemporality, IsMonotonic: true}
+
+		a.Aggregate(ctx, 3, alice)
+		a.Aggregate(ctx, 2, bob)
+		_ = a.Aggregation()
+
+		// Attribute sets not measured are not reported.
+		a.Aggregate(ctx, 5, alice)
+		expect.DataPoints = []metricdata.DataPoint[N]{point[N](alice, 2)}
+		metricdatatest.AssertAggregationsEqual(t, expect, a.Aggregation())
+
+		// An attribute set skipping a cycle is reported relative to its
+		// last measured value.
+		a.Aggregate(ctx, 6, alice)
+		a.Aggregate(ctx, 4, bob)
+		expect.DataPoints = []metricdata.DataPoint[N]{point[N](alice, 1), point[N](bob, 2)}
+		metricdatatest.AssertAggregationsEqual(t, expect, a.Aggregation())
+
+		assert.Nil(t, a.Aggregation(), "no measurements")
+		a.Aggregate(ctx, 7, alice)
+		expect.DataPoints = []metricdata.DataPoint[N]{point[N](alice, 1)}
+		metricdatatest.AssertAggregationsEqual(t, expect, a.Aggregation())
+
+		// Attribute sets not measured for too long are forgotten.
+		for i := 0; i < precomputedMaxStale; i++ {
+			a.Aggregate(ctx, 7, alice)
+			_ = a.Aggregation()
+		}
+		a.Aggregate(ctx, 9, alice)
+		a.Aggregate(ctx, 5, bob)
+		expect.DataPoints = []metricdata.DataPoint[N]{point[N](alice, 2), point[N](bob, 5)}
+		metricdatatest.AssertAggregationsEqual(t, expect, a.Aggregation())
+	})
+}
+
+func TestPrecomputedDeltaSumStartTime(t *testing.T) {
+	orig := now
+	t.Cleanup(func() { now = orig })
+	times := []time.Time{staticTime, staticTime.Add(time.Second), staticTime.Add(2 * time.Second), staticTime.Add(3 * time.Second)}
+	var i int
+	now = func() time.Time {
+		t := times[i]
+		i++
+		return t
+	}
+
+	a := NewPrecomputedDeltaSum[int64](true)
+	ctx := context.Background()
+
+	a.Aggregate(ctx, 1, alice)
+	got := a.Aggregation().(metricdata.Sum[int64])
+	require.Len(t, got.DataPoints, 1)
+	assert.Equal(t, times[0], got.DataPoints[0].StartTime)
+	assert.Equal(t, times[1], got.DataPoints[0].Time)
+
+	// Empty cycles still end the delta collection cycle.
+	assert.Nil(t, a.Aggregation())
+
+	a.Aggregate(ctx, 2, alice)
+	got = a.Aggregation().(metricdata.Sum[int64])
+	require.Len(t, got.DataPoints, 1)
+	assert.Equal(t, times[2], got.DataPoints[0].StartTime)
+	assert.Equal(t, times[3], got.DataPoints[0].Time)
+}
+
+func TestPrecomputedCumulativeSumSetsSingleValue(t *testing.T) {
+	t.Cleanup(mockTime(now))
+
+	agg := NewPrecomputedCumulativeSum[int64](true)
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			agg.Aggregate(context.Background(), 5, alice)
+		}()
+	}
+	wg.Wait()
+
+	metricdatatest.AssertAggregationsEqual(t, metricdata.Sum[int64]{
+		Temporality: metricdata.CumulativeTemporality,
+		IsMonotonic: true,
+		DataPoints:  []metricdata.DataPoint[int64]{point(alice, int64(5))},
+	}, agg.Aggregation())
+}
+
 func TestEmptySumNilAggregation(t *testing.T) {
 	assert.Nil(t, NewCumulativeSum[int64](true).Aggregation())
 	assert.Nil(t, NewCumulativeSum[int64](false).Aggregation())
@@ -34,6 +34,7 @@ type manualReader struct {
 
 	temporalitySelector TemporalitySelector
 	aggregationSelector AggregationSelector
+	producers           []Producer
 }
 
 // Compile time check the manualReader implements Reader and is comparable.
@@ -45,6 +46,7 @@ func NewManualReader(opts ...ManualReaderOption) Reader {
 	return &manualReader{
 		temporalitySelector: cfg.temporalitySelector,
 		aggregationSelector: cfg.aggregationSelector,
+		producers:           cfg.producers,
 	}
 }
 
@@ -86,8 +88,9 @@ func (mr *manualReader) Shutdown(context.Context) error {
 	return err
 }
 
-// Collect gathers all metrics from the SDK, calling any callbacks necessary.
-// Collect will return an error if called after shutdown.
+// Collect gathers all metrics from the SDK, calling any callbacks necessary,
+// and merges them with the metrics of all external Producers. Collect will
+// return an error if called after shutdown.
 func (mr *manualReader) Collect(ctx context.Context) (metricdata.ResourceMetrics, error) {
 	p := mr.producer.Load()
 	if p == nil {
@@ -104,13 +107,19 @@ func (mr *manualReader) Collect(ctx context.Context) (metricdata.ResourceMetrics
 		return metricdata.ResourceMetrics{}, err
 	}
 
-	return ph.produce(ctx)
+	rm, err := ph.produce(ctx)
+	if err != nil {
+		return rm, err
+	}
+	produceExternal(ctx, mr.producers, &rm)
+	return rm, nil
 }
 
 // manualReaderConfig contains configuration options for a ManualReader.
 type manualReaderConfig struct {
 	temporalitySelector TemporalitySelector
 	aggregationSelector AggregationSelector
+	producers           []Producer
 }
 
 // newManualReaderConfig returns a manualReaderConfig configured with options.
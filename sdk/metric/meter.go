@@ -16,7 +16,11 @@ package metric // import "go.opentelemetry.io/otel/sdk/metric"
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/metric/instrument"
 	"go.opentelemetry.io/otel/metric/instrument/asyncfloat64"
@@ -26,6 +30,10 @@ import (
 	"go.opentelemetry.io/otel/sdk/instrumentation"
 )
 
+// errUnregisteredInstrument is reported when a callback makes an observation
+// for an instrument it was not registered with.
+var errUnregisteredInstrument = errors.New("observation for unregistered instrument")
+
 // meter handles the creation and coordination of all metric instruments. A
 // meter represents a single instrumentation scope; all metric telemetry
 // produced by an instrumentation scope will use metric instruments from a
@@ -38,17 +46,16 @@ type meter struct {
 }
 
 func newMeter(s instrumentation.Scope, p pipelines) *meter {
-	// viewCache ensures instrument conflicts, including number conflicts, this
-	// meter is asked to create are logged to the user.
-	var viewCache cache[string, instrumentID]
-
-	// Passing nil as the ac parameter to newInstrumentCache will have each
-	// create its own aggregator cache.
-	ic := newInstrumentCache[int64](nil, &viewCache)
-	fc := newInstrumentCache[float64](nil, &viewCache)
+	// viewCaches ensure instrument conflicts, including number conflicts,
+	// this meter is asked to create are logged to the user. Each pipeline
+	// applies its own views, so conflicts are detected per pipeline.
+	viewCaches := make([]*cache[string, instrumentID], len(p))
+	for i := range viewCaches {
+		viewCaches[i] = &cache[string, instrumentID]{}
+	}
 
-	ir := newResolver(s, p, ic)
-	fr := newResolver(s, p, fc)
+	ir := newResolver[int64](s, p, viewCaches)
+	fr := newResolver[float64](s, p, viewCaches)
 
 	return &meter{
 		pipes:               p,
@@ -72,33 +79,97 @@ func (m *meter) AsyncFloat64() asyncfloat64.InstrumentProvider {
 
 // RegisterCallback registers the function f to be called when any of the
 // insts Collect method is called.
-func (m *meter) RegisterCallback(insts []instrument.Asynchronous, f func(context.Context)) error {
+func (m *meter) RegisterCallback(insts []instrument.Asynchronous, f metric.Callback) (metric.Registration, error) {
+	reg := newObserver()
 	for _, inst := range insts {
 		// Only register if at least one instrument has a non-drop aggregation.
 		// Otherwise, calling f during collection will be wasted computation.
 		switch t := inst.(type) {
 		case *instrumentImpl[int64]:
 			if len(t.aggregators) > 0 {
-				return m.registerCallback(f)
+				reg.int64[t] = struct{}{}
 			}
 		case *instrumentImpl[float64]:
 			if len(t.aggregators) > 0 {
-				return m.registerCallback(f)
+				reg.float64[t] = struct{}{}
 			}
 		default:
 			// Instrument external to the SDK. For example, an instrument from
-			// the "go.opentelemetry.io/otel/metric/internal/global" package.
+			// the "go.opentelemetry.io/otel/metric/internal/global" package
+			// that could not be delegated to this SDK.
 			//
-			// Fail gracefully here, assume a valid instrument.
-			return m.registerCallback(f)
+			// Observations for it cannot be recorded, ignore it.
 		}
 	}
-	// All insts use drop aggregation.
-	return nil
+
+	if reg.len() == 0 {
+		// All insts use drop aggregation.
+		return noopRegister{}, nil
+	}
+
+	return m.pipes.registerCallback(func(ctx context.Context) {
+		f(ctx, reg.bind(ctx))
+	}), nil
+}
+
+// observer is the metric.Observer passed to a registered callback. It only
+// records observations for the instruments the callback was registered with.
+type observer struct {
+	ctx context.Context
+
+	float64 map[*instrumentImpl[float64]]struct{}
+	int64   map[*instrumentImpl[int64]]struct{}
+}
+
+var _ metric.Observer = observer{}
+
+func newObserver() observer {
+	return observer{
+		float64: make(map[*instrumentImpl[float64]]struct{}),
+		int64:   make(map[*instrumentImpl[int64]]struct{}),
+	}
 }
 
-func (m *meter) registerCallback(f func(context.Context)) error {
-	m.pipes.registerCallback(f)
+// len returns the number of instruments registered with o.
+func (o observer) len() int {
+	return len(o.float64) + len(o.int64)
+}
+
+// bind returns a copy of o that records observations with ctx.
+func (o observer) bind(ctx context.Context) observer {
+	o.ctx = ctx
+	return o
+}
+
+// ObserveFloat64 records the float64 value with attrs for inst.
+func (o observer) ObserveFloat64(inst instrument.Asynchronous, value float64, attrs ...attribute.KeyValue) {
+	i, ok := inst.(*instrumentImpl[float64])
+	if ok {
+		_, ok = o.float64[i]
+	}
+	if !ok {
+		otel.Handle(fmt.Errorf("%w: float64 observation", errUnregisteredInstrument))
+		return
+	}
+	observe(o.ctx, func() { i.aggregate(o.ctx, value, attrs) })
+}
+
+// ObserveInt64 records the int64 value with attrs for inst.
+func (o observer) ObserveInt64(inst instrument.Asynchronous, value int64, attrs ...attribute.KeyValue) {
+	i, ok := inst.(*instrumentImpl[int64])
+	if ok {
+		_, ok = o.int64[i]
+	}
+	if !ok {
+		otel.Handle(fmt.Errorf("%w: int64 observation", errUnregisteredInstrument))
+		return
+	}
+	observe(o.ctx, func() { i.aggregate(o.ctx, value, attrs) })
+}
+
+type noopRegister struct{}
+
+func (noopRegister) Unregister() error {
 	return nil
 }
 
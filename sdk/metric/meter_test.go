@@ -16,12 +16,16 @@ package metric
 
 import (
 	"context"
+	"fmt"
 	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/metric/instrument"
@@ -31,16 +35,18 @@ import (
 	"go.opentelemetry.io/otel/metric/instrument/syncint64"
 	"go.opentelemetry.io/otel/sdk/instrumentation"
 	"go.opentelemetry.io/otel/sdk/metric/aggregation"
+	"go.opentelemetry.io/otel/sdk/metric/exemplar"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
 	"go.opentelemetry.io/otel/sdk/metric/view"
 	"go.opentelemetry.io/otel/sdk/resource"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // A meter should be able to make instruments concurrently.
 func TestMeterInstrumentConcurrency(t *testing.T) {
 	wg := &sync.WaitGroup{}
-	wg.Add(12)
+	wg.Add(14)
 
 	m := NewMeterProvider().Meter("inst-concurrency")
 
@@ -80,6 +86,10 @@ func TestMeterInstrumentConcurrency(t *testing.T) {
 		_, _ = m.SyncFloat64().Histogram("SFHistogram")
 		wg.Done()
 	}()
+	go func() {
+		_, _ = m.SyncFloat64().Gauge("SFGauge")
+		wg.Done()
+	}()
 	go func() {
 		_, _ = m.SyncInt64().Counter("SICounter")
 		wg.Done()
@@ -92,6 +102,10 @@ func TestMeterInstrumentConcurrency(t *testing.T) {
 		_, _ = m.SyncInt64().Histogram("SIHistogram")
 		wg.Done()
 	}()
+	go func() {
+		_, _ = m.SyncInt64().Gauge("SIGauge")
+		wg.Done()
+	}()
 
 	wg.Wait()
 }
@@ -104,11 +118,11 @@ func TestMeterCallbackCreationConcurrency(t *testing.T) {
 	m := NewMeterProvider().Meter("callback-concurrency")
 
 	go func() {
-		_ = m.RegisterCallback([]instrument.Asynchronous{}, func(ctx context.Context) {})
+		_, _ = m.RegisterCallback([]instrument.Asynchronous{}, func(_ context.Context, o metric.Observer) {})
 		wg.Done()
 	}()
 	go func() {
-		_ = m.RegisterCallback([]instrument.Asynchronous{}, func(ctx context.Context) {})
+		_, _ = m.RegisterCallback([]instrument.Asynchronous{}, func(_ context.Context, o metric.Observer) {})
 		wg.Done()
 	}()
 	wg.Wait()
@@ -127,8 +141,8 @@ func TestMeterCreatesInstruments(t *testing.T) {
 			fn: func(t *testing.T, m metric.Meter) {
 				ctr, err := m.AsyncInt64().Counter("aint")
 				assert.NoError(t, err)
-				err = m.RegisterCallback([]instrument.Asynchronous{ctr}, func(ctx context.Context) {
-					ctr.Observe(ctx, 3)
+				_, err = m.RegisterCallback([]instrument.Asynchronous{ctr}, func(_ context.Context, o metric.Observer) {
+					o.ObserveInt64(ctr, 3)
 				})
 				assert.NoError(t, err)
 
@@ -151,8 +165,8 @@ func TestMeterCreatesInstruments(t *testing.T) {
 			fn: func(t *testing.T, m metric.Meter) {
 				ctr, err := m.AsyncInt64().UpDownCounter("aint")
 				assert.NoError(t, err)
-				err = m.RegisterCallback([]instrument.Asynchronous{ctr}, func(ctx context.Context) {
-					ctr.Observe(ctx, 11)
+				_, err = m.RegisterCallback([]instrument.Asynchronous{ctr}, func(_ context.Context, o metric.Observer) {
+					o.ObserveInt64(ctr, 11)
 				})
 				assert.NoError(t, err)
 
@@ -175,8 +189,8 @@ func TestMeterCreatesInstruments(t *testing.T) {
 			fn: func(t *testing.T, m metric.Meter) {
 				gauge, err := m.AsyncInt64().Gauge("agauge")
 				assert.NoError(t, err)
-				err = m.RegisterCallback([]instrument.Asynchronous{gauge}, func(ctx context.Context) {
-					gauge.Observe(ctx, 11)
+				_, err = m.RegisterCallback([]instrument.Asynchronous{gauge}, func(_ context.Context, o metric.Observer) {
+					o.ObserveInt64(gauge, 11)
 				})
 				assert.NoError(t, err)
 
@@ -197,8 +211,8 @@ func TestMeterCreatesInstruments(t *testing.T) {
 			fn: func(t *testing.T, m metric.Meter) {
 				ctr, err := m.AsyncFloat64().Counter("afloat")
 				assert.NoError(t, err)
-				err = m.RegisterCallback([]instrument.Asynchronous{ctr}, func(ctx context.Context) {
-					ctr.Observe(ctx, 3)
+				_, err = m.RegisterCallback([]instrument.Asynchronous{ctr}, func(_ context.Context, o metric.Observer) {
+					o.ObserveFloat64(ctr, 3)
 				})
 				assert.NoError(t, err)
 
@@ -221,8 +235,8 @@ func TestMeterCreatesInstruments(t *testing.T) {
 			fn: func(t *testing.T, m metric.Meter) {
 				ctr, err := m.AsyncFloat64().UpDownCounter("afloat")
 				assert.NoError(t, err)
-				err = m.RegisterCallback([]instrument.Asynchronous{ctr}, func(ctx context.Context) {
-					ctr.Observe(ctx, 11)
+				_, err = m.RegisterCallback([]instrument.Asynchronous{ctr}, func(_ context.Context, o metric.Observer) {
+					o.ObserveFloat64(ctr, 11)
 				})
 				assert.NoError(t, err)
 
@@ -245,8 +259,8 @@ func TestMeterCreatesInstruments(t *testing.T) {
 			fn: func(t *testing.T, m metric.Meter) {
 				gauge, err := m.AsyncFloat64().Gauge("agauge")
 				assert.NoError(t, err)
-				err = m.RegisterCallback([]instrument.Asynchronous{gauge}, func(ctx context.Context) {
-					gauge.Observe(ctx, 11)
+				_, err = m.RegisterCallback([]instrument.Asynchronous{gauge}, func(_ context.Context, o metric.Observer) {
+					o.ObserveFloat64(gauge, 11)
 				})
 				assert.NoError(t, err)
 
@@ -301,6 +315,24 @@ func TestMeterCreatesInstruments(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "SyncInt64Gauge",
+			fn: func(t *testing.T, m metric.Meter) {
+				gauge, err := m.SyncInt64().Gauge("gauge")
+				assert.NoError(t, err)
+
+				gauge.Record(context.Background(), 3)
+				gauge.Record(context.Background(), 11)
+			},
+			want: metricdata.Metrics{
+				Name: "gauge",
+				Data: metricdata.Gauge[int64]{
+					DataPoints: []metricdata.DataPoint[int64]{
+						{Value: 11},
+					},
+				},
+			},
+		},
 		{
 			name: "SyncInt64Histogram",
 			fn: func(t *testing.T, m metric.Meter) {
@@ -365,6 +397,24 @@ func TestMeterCreatesInstruments(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "SyncFloat64Gauge",
+			fn: func(t *testing.T, m metric.Meter) {
+				gauge, err := m.SyncFloat64().Gauge("gauge")
+				assert.NoError(t, err)
+
+				gauge.Record(context.Background(), 3)
+				gauge.Record(context.Background(), 11)
+			},
+			want: metricdata.Metrics{
+				Name: "gauge",
+				Data: metricdata.Gauge[float64]{
+					DataPoints: []metricdata.DataPoint[float64]{
+						{Value: 11},
+					},
+				},
+			},
+		},
 		{
 			name: "SyncFloat64Histogram",
 			fn: func(t *testing.T, m metric.Meter) {
@@ -419,16 +469,16 @@ func TestMetersProvideScope(t *testing.T) {
 	m1 := mp.Meter("scope1")
 	ctr1, err := m1.AsyncFloat64().Counter("ctr1")
 	assert.NoError(t, err)
-	err = m1.RegisterCallback([]instrument.Asynchronous{ctr1}, func(ctx context.Context) {
-		ctr1.Observe(ctx, 5)
+	_, err = m1.RegisterCallback([]instrument.Asynchronous{ctr1}, func(_ context.Context, o metric.Observer) {
+		o.ObserveFloat64(ctr1, 5)
 	})
 	assert.NoError(t, err)
 
 	m2 := mp.Meter("scope2")
 	ctr2, err := m2.AsyncInt64().Counter("ctr2")
 	assert.NoError(t, err)
-	err = m1.RegisterCallback([]instrument.Asynchronous{ctr2}, func(ctx context.Context) {
-		ctr2.Observe(ctx, 7)
+	_, err = m1.RegisterCallback([]instrument.Asynchronous{ctr2}, func(_ context.Context, o metric.Observer) {
+		o.ObserveInt64(ctr2, 7)
 	})
 	assert.NoError(t, err)
 
@@ -508,14 +558,15 @@ func TestRegisterCallbackDropAggregations(t *testing.T) {
 	require.NoError(t, err)
 
 	var called bool
-	require.NoError(t, m.RegisterCallback([]instrument.Asynchronous{
+	_, err = m.RegisterCallback([]instrument.Asynchronous{
 		int64Counter,
 		int64UpDownCounter,
 		int64Gauge,
 		floag64Counter,
 		floag64UpDownCounter,
 		floag64Gauge,
-	}, func(context.Context) { called = true }))
+	}, func(context.Context, metric.Observer) { called = true })
+	require.NoError(t, err)
 
 	data, err := r.Collect(context.Background())
 	require.NoError(t, err)
@@ -524,6 +575,77 @@ func TestRegisterCallbackDropAggregations(t *testing.T) {
 	assert.Len(t, data.ScopeMetrics, 0, "metrics exported for drop instruments")
 }
 
+func TestRegistrationUnregister(t *testing.T) {
+	r := NewManualReader()
+	mp := NewMeterProvider(WithReader(r))
+	m := mp.Meter("TestRegistrationUnregister")
+
+	ctr, err := m.AsyncInt64().Counter("int64.counter")
+	require.NoError(t, err)
+
+	var called int
+	reg, err := m.RegisterCallback([]instrument.Asynchronous{ctr}, func(_ context.Context, o metric.Observer) {
+		called++
+		o.ObserveInt64(ctr, 1)
+	})
+	require.NoError(t, err)
+
+	_, err = r.Collect(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, called, "registered callback not called")
+
+	require.NoError(t, reg.Unregister())
+	_, err = r.Collect(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, called, "unregistered callback called")
+
+	assert.NotPanics(t, func() {
+		assert.NoError(t, reg.Unregister(), "duplicate unregister calls")
+	})
+}
+
+func TestObserverValidatesInstruments(t *testing.T) {
+	var errs []error
+	setErrorHandler(t, otel.ErrorHandlerFunc(func(err error) {
+		errs = append(errs, err)
+	}))
+
+	r := NewManualReader()
+	mp := NewMeterProvider(WithReader(r))
+	m := mp.Meter("TestObserverValidatesInstruments")
+
+	registered, err := m.AsyncInt64().Gauge("registered")
+	require.NoError(t, err)
+	unregistered, err := m.AsyncInt64().Gauge("unregistered")
+	require.NoError(t, err)
+
+	_, err = m.RegisterCallback([]instrument.Asynchronous{registered}, func(_ context.Context, o metric.Observer) {
+		o.ObserveInt64(registered, 1)
+		o.ObserveInt64(unregistered, 2)
+		// Mismatched value type.
+		o.ObserveFloat64(registered, 3)
+	})
+	require.NoError(t, err)
+
+	rm, err := r.Collect(context.Background())
+	require.NoError(t, err)
+
+	require.Len(t, errs, 2)
+	for _, err := range errs {
+		assert.ErrorIs(t, err, errUnregisteredInstrument)
+	}
+
+	require.Len(t, rm.ScopeMetrics, 1)
+	require.Len(t, rm.ScopeMetrics[0].Metrics, 1, "unregistered instrument observed")
+	want := metricdata.Metrics{
+		Name: "registered",
+		Data: metricdata.Gauge[int64]{
+			DataPoints: []metricdata.DataPoint[int64]{{Value: 1}},
+		},
+	}
+	metricdatatest.AssertEqual(t, want, rm.ScopeMetrics[0].Metrics[0], metricdatatest.IgnoreTimestamp())
+}
+
 func TestAttributeFilter(t *testing.T) {
 	one := 1.0
 	two := 2.0
@@ -539,10 +661,11 @@ func TestAttributeFilter(t *testing.T) {
 				if err != nil {
 					return err
 				}
-				return mtr.RegisterCallback([]instrument.Asynchronous{ctr}, func(ctx context.Context) {
-					ctr.Observe(ctx, 1.0, attribute.String("foo", "bar"), attribute.Int("version", 1))
-					ctr.Observe(ctx, 2.0, attribute.String("foo", "bar"), attribute.Int("version", 2))
+				_, err = mtr.RegisterCallback([]instrument.Asynchronous{ctr}, func(_ context.Context, o metric.Observer) {
+					o.ObserveFloat64(ctr, 1.0, attribute.String("foo", "bar"), attribute.Int("version", 1))
+					o.ObserveFloat64(ctr, 2.0, attribute.String("foo", "bar"), attribute.Int("version", 2))
 				})
+				return err
 			},
 			wantMetric: metricdata.Metrics{
 				Name: "afcounter",
@@ -565,10 +688,11 @@ func TestAttributeFilter(t *testing.T) {
 				if err != nil {
 					return err
 				}
-				return mtr.RegisterCallback([]instrument.Asynchronous{ctr}, func(ctx context.Context) {
-					ctr.Observe(ctx, 1.0, attribute.String("foo", "bar"), attribute.Int("version", 1))
-					ctr.Observe(ctx, 2.0, attribute.String("foo", "bar"), attribute.Int("version", 2))
+				_, err = mtr.RegisterCallback([]instrument.Asynchronous{ctr}, func(_ context.Context, o metric.Observer) {
+					o.ObserveFloat64(ctr, 1.0, attribute.String("foo", "bar"), attribute.Int("version", 1))
+					o.ObserveFloat64(ctr, 2.0, attribute.String("foo", "bar"), attribute.Int("version", 2))
 				})
+				return err
 			},
 			wantMetric: metricdata.Metrics{
 				Name: "afupdowncounter",
@@ -591,10 +715,11 @@ func TestAttributeFilter(t *testing.T) {
 				if err != nil {
 					return err
 				}
-				return mtr.RegisterCallback([]instrument.Asynchronous{ctr}, func(ctx context.Context) {
-					ctr.Observe(ctx, 1.0, attribute.String("foo", "bar"), attribute.Int("version", 1))
-					ctr.Observe(ctx, 2.0, attribute.String("foo", "bar"), attribute.Int("version", 2))
+				_, err = mtr.RegisterCallback([]instrument.Asynchronous{ctr}, func(_ context.Context, o metric.Observer) {
+					o.ObserveFloat64(ctr, 1.0, attribute.String("foo", "bar"), attribute.Int("version", 1))
+					o.ObserveFloat64(ctr, 2.0, attribute.String("foo", "bar"), attribute.Int("version", 2))
 				})
+				return err
 			},
 			wantMetric: metricdata.Metrics{
 				Name: "afgauge",
@@ -615,10 +740,11 @@ func TestAttributeFilter(t *testing.T) {
 				if err != nil {
 					return err
 				}
-				return mtr.RegisterCallback([]instrument.Asynchronous{ctr}, func(ctx context.Context) {
-					ctr.Observe(ctx, 10, attribute.String("foo", "bar"), attribute.Int("version", 1))
-					ctr.Observe(ctx, 20, attribute.String("foo", "bar"), attribute.Int("version", 2))
+				_, err = mtr.RegisterCallback([]instrument.Asynchronous{ctr}, func(_ context.Context, o metric.Observer) {
+					o.ObserveInt64(ctr, 10, attribute.String("foo", "bar"), attribute.Int("version", 1))
+					o.ObserveInt64(ctr, 20, attribute.String("foo", "bar"), attribute.Int("version", 2))
 				})
+				return err
 			},
 			wantMetric: metricdata.Metrics{
 				Name: "aicounter",
@@ -641,10 +767,11 @@ func TestAttributeFilter(t *testing.T) {
 				if err != nil {
 					return err
 				}
-				return mtr.RegisterCallback([]instrument.Asynchronous{ctr}, func(ctx context.Context) {
-					ctr.Observe(ctx, 10, attribute.String("foo", "bar"), attribute.Int("version", 1))
-					ctr.Observe(ctx, 20, attribute.String("foo", "bar"), attribute.Int("version", 2))
+				_, err = mtr.RegisterCallback([]instrument.Asynchronous{ctr}, func(_ context.Context, o metric.Observer) {
+					o.ObserveInt64(ctr, 10, attribute.String("foo", "bar"), attribute.Int("version", 1))
+					o.ObserveInt64(ctr, 20, attribute.String("foo", "bar"), attribute.Int("version", 2))
 				})
+				return err
 			},
 			wantMetric: metricdata.Metrics{
 				Name: "aiupdowncounter",
@@ -667,10 +794,11 @@ func TestAttributeFilter(t *testing.T) {
 				if err != nil {
 					return err
 				}
-				return mtr.RegisterCallback([]instrument.Asynchronous{ctr}, func(ctx context.Context) {
-					ctr.Observe(ctx, 10, attribute.String("foo", "bar"), attribute.Int("version", 1))
-					ctr.Observe(ctx, 20, attribute.String("foo", "bar"), attribute.Int("version", 2))
+				_, err = mtr.RegisterCallback([]instrument.Asynchronous{ctr}, func(_ context.Context, o metric.Observer) {
+					o.ObserveInt64(ctr, 10, attribute.String("foo", "bar"), attribute.Int("version", 1))
+					o.ObserveInt64(ctr, 20, attribute.String("foo", "bar"), attribute.Int("version", 2))
 				})
+				return err
 			},
 			wantMetric: metricdata.Metrics{
 				Name: "aigauge",
@@ -877,6 +1005,507 @@ func TestAttributeFilter(t *testing.T) {
 	}
 }
 
+func TestAttributeTransform(t *testing.T) {
+	v, err := view.New(
+		view.MatchInstrumentName("requests"),
+		view.WithTransformAttributes(func(kv attribute.KeyValue) (attribute.KeyValue, bool) {
+			if kv.Key == "http.status_code" {
+				return kv.Key.String(fmt.Sprintf("%dxx", kv.Value.AsInt64()/100)), true
+			}
+			return kv, kv.Key != "user"
+		}),
+	)
+	require.NoError(t, err)
+	rdr := NewManualReader()
+	mtr := NewMeterProvider(
+		WithReader(rdr),
+		WithView(v),
+	).Meter("TestAttributeTransform")
+
+	ctr, err := mtr.SyncInt64().Counter("requests")
+	require.NoError(t, err)
+	ctx := context.Background()
+	ctr.Add(ctx, 1, attribute.Int("http.status_code", 200), attribute.String("user", "alice"))
+	ctr.Add(ctx, 2, attribute.Int("http.status_code", 201), attribute.String("user", "bob"))
+	ctr.Add(ctx, 4, attribute.Int("http.status_code", 500), attribute.String("user", "alice"))
+
+	m, err := rdr.Collect(ctx)
+	require.NoError(t, err)
+	require.Len(t, m.ScopeMetrics, 1)
+	require.Len(t, m.ScopeMetrics[0].Metrics, 1)
+
+	want := metricdata.Metrics{
+		Name: "requests",
+		Data: metricdata.Sum[int64]{
+			DataPoints: []metricdata.DataPoint[int64]{
+				{
+					Attributes: attribute.NewSet(attribute.String("http.status_code", "2xx")),
+					Value:      3,
+				},
+				{
+					Attributes: attribute.NewSet(attribute.String("http.status_code", "5xx")),
+					Value:      4,
+				},
+			},
+			Temporality: metricdata.CumulativeTemporality,
+			IsMonotonic: true,
+		},
+	}
+	metricdatatest.AssertEqual(t, want, m.ScopeMetrics[0].Metrics[0], metricdatatest.IgnoreTimestamp())
+}
+
+func TestReaderViews(t *testing.T) {
+	histView, err := view.New(
+		view.MatchInstrumentName("latency"),
+		view.WithSetAggregation(aggregation.ExplicitBucketHistogram{
+			Boundaries: []float64{1, 10},
+		}),
+	)
+	require.NoError(t, err)
+	sumView, err := view.New(
+		view.MatchInstrumentName("latency"),
+		view.WithSetAggregation(aggregation.Sum{}),
+	)
+	require.NoError(t, err)
+
+	histRdr, sumRdr := NewManualReader(), NewManualReader()
+	mtr := NewMeterProvider(
+		WithReader(histRdr, histView),
+		WithReader(sumRdr, sumView),
+	).Meter("TestReaderViews")
+
+	hist, err := mtr.SyncInt64().Histogram("latency")
+	require.NoError(t, err)
+	ctx := context.Background()
+	hist.Record(ctx, 2)
+	hist.Record(ctx, 5)
+
+	minimum, maximum := 2.0, 5.0
+	want := metricdata.Metrics{
+		Name: "latency",
+		Data: metricdata.Histogram{
+			DataPoints: []metricdata.HistogramDataPoint{{
+				Attributes:   *attribute.EmptySet(),
+				Bounds:       []float64{1, 10},
+				BucketCounts: []uint64{0, 2, 0},
+				Count:        2,
+				Min:          &minimum,
+				Max:          &maximum,
+				Sum:          7,
+			}},
+			Temporality: metricdata.CumulativeTemporality,
+		},
+	}
+	m, err := histRdr.Collect(ctx)
+	require.NoError(t, err)
+	require.Len(t, m.ScopeMetrics, 1)
+	require.Len(t, m.ScopeMetrics[0].Metrics, 1)
+	metricdatatest.AssertEqual(t, want, m.ScopeMetrics[0].Metrics[0], metricdatatest.IgnoreTimestamp())
+
+	want = metricdata.Metrics{
+		Name: "latency",
+		Data: metricdata.Sum[int64]{
+			DataPoints: []metricdata.DataPoint[int64]{{
+				Attributes: *attribute.EmptySet(),
+				Value:      7,
+			}},
+			Temporality: metricdata.CumulativeTemporality,
+			IsMonotonic: true,
+		},
+	}
+	m, err = sumRdr.Collect(ctx)
+	require.NoError(t, err)
+	require.Len(t, m.ScopeMetrics, 1)
+	require.Len(t, m.ScopeMetrics[0].Metrics, 1)
+	metricdatatest.AssertEqual(t, want, m.ScopeMetrics[0].Metrics[0], metricdatatest.IgnoreTimestamp())
+}
+
+func TestMultipleReadersIndependent(t *testing.T) {
+	r0, r1 := NewManualReader(), NewManualReader()
+	mtr := NewMeterProvider(WithReader(r0), WithReader(r1)).Meter("TestMultipleReadersIndependent")
+
+	ctr, err := mtr.SyncInt64().Counter("requests")
+	require.NoError(t, err)
+	ctr.Add(context.Background(), 1)
+
+	want := metricdata.Metrics{
+		Name: "requests",
+		Data: metricdata.Sum[int64]{
+			DataPoints: []metricdata.DataPoint[int64]{{
+				Attributes: *attribute.EmptySet(),
+				Value:      1,
+			}},
+			Temporality: metricdata.CumulativeTemporality,
+			IsMonotonic: true,
+		},
+	}
+	for _, r := range []Reader{r0, r1} {
+		m, err := r.Collect(context.Background())
+		require.NoError(t, err)
+		require.Len(t, m.ScopeMetrics, 1)
+		require.Len(t, m.ScopeMetrics[0].Metrics, 1)
+		metricdatatest.AssertEqual(t, want, m.ScopeMetrics[0].Metrics[0], metricdatatest.IgnoreTimestamp())
+	}
+}
+
+func TestExplicitBucketBoundariesAdvice(t *testing.T) {
+	advice := instrument.WithExplicitBucketBoundaries(1, 2, 3)
+	viewBounds := []float64{10, 20}
+	defaultBounds := DefaultAggregationSelector(view.SyncHistogram).(aggregation.ExplicitBucketHistogram).Boundaries
+
+	testcases := []struct {
+		name       string
+		opts       []instrument.Option
+		views      []view.View
+		wantBounds []float64
+	}{
+		{
+			name:       "NoAdvice",
+			wantBounds: defaultBounds,
+		},
+		{
+			name:       "Advice",
+			opts:       []instrument.Option{advice},
+			wantBounds: []float64{1, 2, 3},
+		},
+		{
+			name:       "EmptyAdvice",
+			opts:       []instrument.Option{instrument.WithExplicitBucketBoundaries()},
+			wantBounds: []float64{},
+		},
+		{
+			name:       "InvalidAdvice",
+			opts:       []instrument.Option{instrument.WithExplicitBucketBoundaries(3, 2, 1)},
+			wantBounds: defaultBounds,
+		},
+		{
+			name: "ViewAggregationTakesPrecedence",
+			opts: []instrument.Option{advice},
+			views: []view.View{func() view.View {
+				v, err := view.New(
+					view.MatchInstrumentName("*"),
+					view.WithSetAggregation(aggregation.ExplicitBucketHistogram{Boundaries: viewBounds}),
+				)
+				require.NoError(t, err)
+				return v
+			}()},
+			wantBounds: viewBounds,
+		},
+		{
+			name: "ViewWithoutAggregation",
+			opts: []instrument.Option{advice},
+			views: []view.View{func() view.View {
+				v, err := view.New(
+					view.MatchInstrumentName("*"),
+					view.WithSetDescription("histogram"),
+				)
+				require.NoError(t, err)
+				return v
+			}()},
+			wantBounds: []float64{1, 2, 3},
+		},
+	}
+
+	for _, tt := range testcases {
+		t.Run(tt.name, func(t *testing.T) {
+			rdr := NewManualReader()
+			mtr := NewMeterProvider(
+				WithReader(rdr),
+				WithView(tt.views...),
+			).Meter("TestExplicitBucketBoundariesAdvice")
+
+			hist, err := mtr.SyncFloat64().Histogram("histogram", tt.opts...)
+			require.NoError(t, err)
+			hist.Record(context.Background(), 1)
+
+			m, err := rdr.Collect(context.Background())
+			require.NoError(t, err)
+			require.Len(t, m.ScopeMetrics, 1)
+			require.Len(t, m.ScopeMetrics[0].Metrics, 1)
+			data, ok := m.ScopeMetrics[0].Metrics[0].Data.(metricdata.Histogram)
+			require.Truef(t, ok, "unexpected data type %T", m.ScopeMetrics[0].Metrics[0].Data)
+			require.Len(t, data.DataPoints, 1)
+			assert.Equal(t, tt.wantBounds, data.DataPoints[0].Bounds)
+		})
+	}
+}
+
+func TestExplicitBucketBoundariesAdviceIgnored(t *testing.T) {
+	rdr := NewManualReader()
+	mtr := NewMeterProvider(WithReader(rdr)).Meter("TestExplicitBucketBoundariesAdviceIgnored")
+
+	ctr, err := mtr.SyncInt64().Counter("counter", instrument.WithExplicitBucketBoundaries(1, 2, 3))
+	require.NoError(t, err)
+	ctr.Add(context.Background(), 1)
+
+	m, err := rdr.Collect(context.Background())
+	require.NoError(t, err)
+	require.Len(t, m.ScopeMetrics, 1)
+	require.Len(t, m.ScopeMetrics[0].Metrics, 1)
+	assert.IsType(t, metricdata.Sum[int64]{}, m.ScopeMetrics[0].Metrics[0].Data)
+}
+
+func TestPrecomputedAttributeSet(t *testing.T) {
+	rdr := NewManualReader()
+	mtr := NewMeterProvider(WithReader(rdr)).Meter("TestPrecomputedAttributeSet")
+
+	ctr, err := mtr.SyncInt64().Counter("counter")
+	require.NoError(t, err)
+	gauge, err := mtr.SyncFloat64().Gauge("gauge")
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	set := attribute.NewSet(attribute.String("user", "alice"))
+	ctr.AddSet(ctx, 1, set)
+	// Measurements made with the same attributes are aggregated together.
+	ctr.Add(ctx, 2, attribute.String("user", "alice"))
+	gauge.RecordSet(ctx, 3, set)
+
+	m, err := rdr.Collect(ctx)
+	require.NoError(t, err)
+	require.Len(t, m.ScopeMetrics, 1)
+	want := metricdata.ScopeMetrics{
+		Scope: instrumentation.Scope{Name: "TestPrecomputedAttributeSet"},
+		Metrics: []metricdata.Metrics{
+			{
+				Name: "counter",
+				Data: metricdata.Sum[int64]{
+					DataPoints:  []metricdata.DataPoint[int64]{{Attributes: set, Value: 3}},
+					Temporality: metricdata.CumulativeTemporality,
+					IsMonotonic: true,
+				},
+			},
+			{
+				Name: "gauge",
+				Data: metricdata.Gauge[float64]{
+					DataPoints: []metricdata.DataPoint[float64]{{Attributes: set, Value: 3}},
+				},
+			},
+		},
+	}
+	metricdatatest.AssertEqual(t, want, m.ScopeMetrics[0], metricdatatest.IgnoreTimestamp())
+}
+
+func TestPrecomputedAttributeSetAllocations(t *testing.T) {
+	rdr := NewManualReader()
+	mtr := NewMeterProvider(WithReader(rdr)).Meter("TestPrecomputedAttributeSetAllocations")
+
+	ctr, err := mtr.SyncInt64().Counter("counter")
+	require.NoError(t, err)
+	hist, err := mtr.SyncFloat64().Histogram("histogram")
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	set := attribute.NewSet(attribute.String("user", "alice"), attribute.Int("id", 1))
+	// Create the aggregated series, only new series are expected to allocate.
+	ctr.AddSet(ctx, 1, set)
+	hist.RecordSet(ctx, 1, set)
+
+	allocs := testing.AllocsPerRun(100, func() {
+		ctr.AddSet(ctx, 1, set)
+		hist.RecordSet(ctx, 1, set)
+	})
+	assert.Zero(t, allocs)
+}
+
+func TestCardinalityLimit(t *testing.T) {
+	var errs []error
+	setErrorHandler(t, otel.ErrorHandlerFunc(func(err error) {
+		errs = append(errs, err)
+	}))
+
+	v, err := view.New(
+		view.MatchInstrumentName("limited"),
+		view.WithCardinalityLimit(2),
+	)
+	require.NoError(t, err)
+	rdr := NewManualReader()
+	mtr := NewMeterProvider(
+		WithReader(rdr),
+		WithView(v),
+		WithCardinalityLimit(3),
+	).Meter("TestCardinalityLimit")
+
+	ctx := context.Background()
+	limited, err := mtr.SyncInt64().Counter("limited")
+	require.NoError(t, err)
+	ctr, err := mtr.SyncInt64().Counter("counter")
+	require.NoError(t, err)
+	for i := 0; i < 5; i++ {
+		limited.Add(ctx, 1, attribute.Int("i", i))
+		ctr.Add(ctx, 1, attribute.Int("i", i))
+	}
+
+	overflow := attribute.NewSet(attribute.Bool("otel.metric.overflow", true))
+	want := metricdata.ScopeMetrics{
+		Scope: instrumentation.Scope{Name: "TestCardinalityLimit"},
+		Metrics: []metricdata.Metrics{
+			{
+				Name: "limited",
+				Data: metricdata.Sum[int64]{
+					Temporality: metricdata.CumulativeTemporality,
+					IsMonotonic: true,
+					DataPoints: []metricdata.DataPoint[int64]{
+						{Attributes: attribute.NewSet(attribute.Int("i", 0)), Value: 1},
+						{Attributes: overflow, Value: 4},
+					},
+				},
+			},
+			{
+				Name: "counter",
+				Data: metricdata.Sum[int64]{
+					Temporality: metricdata.CumulativeTemporality,
+					IsMonotonic: true,
+					DataPoints: []metricdata.DataPoint[int64]{
+						{Attributes: attribute.NewSet(attribute.Int("i", 0)), Value: 1},
+						{Attributes: attribute.NewSet(attribute.Int("i", 1)), Value: 1},
+						{Attributes: overflow, Value: 3},
+					},
+				},
+			},
+		},
+	}
+
+	m, err := rdr.Collect(ctx)
+	require.NoError(t, err)
+	require.Len(t, m.ScopeMetrics, 1)
+	metricdatatest.AssertEqual(t, want, m.ScopeMetrics[0], metricdatatest.IgnoreTimestamp())
+	assert.Len(t, errs, 2, "limits reached not reported once per instrument")
+}
+
+func TestExemplars(t *testing.T) {
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{0x01},
+		SpanID:     trace.SpanID{0x01},
+		TraceFlags: trace.FlagsSampled,
+	})
+	sampled := trace.ContextWithSpanContext(context.Background(), sc)
+
+	v, err := view.New(
+		view.MatchInstrumentName("*"),
+		view.WithFilterAttributes(attribute.Key("foo")),
+	)
+	require.NoError(t, err)
+	rdr := NewManualReader()
+	mtr := NewMeterProvider(
+		WithReader(rdr),
+		WithView(v),
+		WithExemplarFilter(exemplar.TraceBasedFilter),
+	).Meter("TestExemplars")
+
+	ctr, err := mtr.SyncInt64().Counter("sicounter")
+	require.NoError(t, err)
+	ctr.Add(sampled, 10, attribute.String("foo", "bar"), attribute.Int("version", 1))
+	ctr.Add(context.Background(), 20, attribute.String("foo", "bar"), attribute.Int("version", 2))
+
+	hist, err := mtr.SyncFloat64().Histogram("sfhistogram")
+	require.NoError(t, err)
+	hist.Record(sampled, 1, attribute.String("foo", "bar"))
+	hist.Record(sampled, 7, attribute.String("foo", "bar"))
+	hist.Record(context.Background(), 8, attribute.String("foo", "bar"))
+
+	m, err := rdr.Collect(context.Background())
+	require.NoError(t, err)
+	require.Len(t, m.ScopeMetrics, 1)
+	require.Len(t, m.ScopeMetrics[0].Metrics, 2)
+
+	sID, tID := sc.SpanID(), sc.TraceID()
+	attrs := attribute.NewSet(attribute.String("foo", "bar"))
+	sum := m.ScopeMetrics[0].Metrics[0].Data.(metricdata.Sum[int64])
+	require.Len(t, sum.DataPoints, 1)
+	assert.Equal(t, attrs, sum.DataPoints[0].Attributes)
+	metricdatatest.AssertEqual(t, metricdata.Exemplar[int64]{
+		FilteredAttributes: []attribute.KeyValue{attribute.Int("version", 1)},
+		Value:              10,
+		SpanID:             sID[:],
+		TraceID:            tID[:],
+	}, sum.DataPoints[0].Exemplars[0], metricdatatest.IgnoreTimestamp())
+
+	h := m.ScopeMetrics[0].Metrics[1].Data.(metricdata.Histogram)
+	require.Len(t, h.DataPoints, 1)
+	// Each measurement is in a different bucket of the default boundaries.
+	require.Len(t, h.DataPoints[0].Exemplars, 2)
+	assert.Equal(t, 1.0, h.DataPoints[0].Exemplars[0].Value)
+	assert.Equal(t, 7.0, h.DataPoints[0].Exemplars[1].Value)
+}
+
+func TestExemplarsRenamedAttributesNotFiltered(t *testing.T) {
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{0x01},
+		SpanID:     trace.SpanID{0x01},
+		TraceFlags: trace.FlagsSampled,
+	})
+	sampled := trace.ContextWithSpanContext(context.Background(), sc)
+
+	v, err := view.New(
+		view.MatchInstrumentName("*"),
+		view.WithTransformAttributes(func(kv attribute.KeyValue) (attribute.KeyValue, bool) {
+			switch kv.Key {
+			case "user":
+				// Rename.
+				return attribute.String("user.name", kv.Value.AsString()), true
+			case "session":
+				return kv, false
+			}
+			return kv, true
+		}),
+	)
+	require.NoError(t, err)
+	rdr := NewManualReader()
+	mtr := NewMeterProvider(
+		WithReader(rdr),
+		WithView(v),
+		WithExemplarFilter(exemplar.TraceBasedFilter),
+	).Meter("TestExemplarsRenamedAttributesNotFiltered")
+
+	ctr, err := mtr.SyncInt64().Counter("sicounter")
+	require.NoError(t, err)
+	ctr.Add(sampled, 10,
+		attribute.String("user", "alice"),
+		attribute.String("session", "1234"),
+		attribute.Int("version", 1),
+	)
+
+	m, err := rdr.Collect(context.Background())
+	require.NoError(t, err)
+	require.Len(t, m.ScopeMetrics, 1)
+	require.Len(t, m.ScopeMetrics[0].Metrics, 1)
+
+	sum := m.ScopeMetrics[0].Metrics[0].Data.(metricdata.Sum[int64])
+	require.Len(t, sum.DataPoints, 1)
+	want := attribute.NewSet(attribute.String("user.name", "alice"), attribute.Int("version", 1))
+	assert.Equal(t, want, sum.DataPoints[0].Attributes)
+	require.Len(t, sum.DataPoints[0].Exemplars, 1)
+	// Only the removed attribute is filtered, not the renamed one.
+	assert.Equal(t,
+		[]attribute.KeyValue{attribute.String("session", "1234")},
+		sum.DataPoints[0].Exemplars[0].FilteredAttributes,
+	)
+}
+
+func TestExemplarsDisabledByDefault(t *testing.T) {
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{0x01},
+		SpanID:     trace.SpanID{0x01},
+		TraceFlags: trace.FlagsSampled,
+	})
+	sampled := trace.ContextWithSpanContext(context.Background(), sc)
+
+	rdr := NewManualReader()
+	mtr := NewMeterProvider(WithReader(rdr)).Meter("TestExemplarsDisabledByDefault")
+	ctr, err := mtr.SyncInt64().Counter("sicounter")
+	require.NoError(t, err)
+	ctr.Add(sampled, 10)
+
+	m, err := rdr.Collect(context.Background())
+	require.NoError(t, err)
+	require.Len(t, m.ScopeMetrics, 1)
+	require.Len(t, m.ScopeMetrics[0].Metrics, 1)
+	sum := m.ScopeMetrics[0].Metrics[0].Data.(metricdata.Sum[int64])
+	require.Len(t, sum.DataPoints, 1)
+	assert.Nil(t, sum.DataPoints[0].Exemplars)
+}
+
 var (
 	aiCounter       asyncint64.Counter
 	aiUpDownCounter asyncint64.UpDownCounter
@@ -920,3 +1549,170 @@ func BenchmarkInstrumentCreation(b *testing.B) {
 		sfHistogram, _ = meter.SyncFloat64().Histogram("sync.float64.histogram")
 	}
 }
+
+func TestCallbackPanicIsolated(t *testing.T) {
+	var errs []error
+	setErrorHandler(t, otel.ErrorHandlerFunc(func(err error) {
+		errs = append(errs, err)
+	}))
+
+	rdr := NewManualReader()
+	mtr := NewMeterProvider(WithReader(rdr)).Meter("TestCallbackPanicIsolated")
+
+	panics, err := mtr.AsyncInt64().Gauge("panics")
+	require.NoError(t, err)
+	_, err = mtr.RegisterCallback([]instrument.Asynchronous{panics}, func(_ context.Context, o metric.Observer) {
+		panic("callback failure")
+	})
+	require.NoError(t, err)
+
+	ok, err := mtr.AsyncInt64().Gauge("ok")
+	require.NoError(t, err)
+	_, err = mtr.RegisterCallback([]instrument.Asynchronous{ok}, func(_ context.Context, o metric.Observer) {
+		o.ObserveInt64(ok, 1)
+	})
+	require.NoError(t, err)
+
+	m, err := rdr.Collect(context.Background())
+	require.NoError(t, err)
+
+	require.Len(t, errs, 1)
+	assert.ErrorIs(t, errs[0], errCallbackPanic)
+
+	require.Len(t, m.ScopeMetrics, 1)
+	require.Len(t, m.ScopeMetrics[0].Metrics, 1)
+	want := metricdata.Metrics{
+		Name: "ok",
+		Data: metricdata.Gauge[int64]{
+			DataPoints: []metricdata.DataPoint[int64]{{Value: 1}},
+		},
+	}
+	metricdatatest.AssertEqual(t, want, m.ScopeMetrics[0].Metrics[0], metricdatatest.IgnoreTimestamp())
+}
+
+func TestCallbackTimeout(t *testing.T) {
+	var (
+		mu   sync.Mutex
+		errs []error
+	)
+	setErrorHandler(t, otel.ErrorHandlerFunc(func(err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		errs = append(errs, err)
+	}))
+
+	rdr := NewManualReader()
+	mtr := NewMeterProvider(
+		WithReader(rdr),
+		WithCallbackTimeout(10*time.Millisecond),
+	).Meter("TestCallbackTimeout")
+
+	var (
+		runs     int32
+		release  = make(chan struct{})
+		lateDone = make(chan struct{})
+	)
+	slow, err := mtr.AsyncInt64().Gauge("slow")
+	require.NoError(t, err)
+	_, err = mtr.RegisterCallback([]instrument.Asynchronous{slow}, func(ctx context.Context, o metric.Observer) {
+		if atomic.AddInt32(&runs, 1) > 1 {
+			return
+		}
+		// Block the first run until after it is abandoned.
+		<-release
+		// Observations made after the timeout are dropped.
+		o.ObserveInt64(slow, 1)
+		slow.Observe(ctx, 1)
+		close(lateDone)
+	})
+	require.NoError(t, err)
+
+	fast, err := mtr.AsyncInt64().Gauge("fast")
+	require.NoError(t, err)
+	_, err = mtr.RegisterCallback([]instrument.Asynchronous{fast}, func(_ context.Context, o metric.Observer) {
+		o.ObserveInt64(fast, 1)
+	})
+	require.NoError(t, err)
+
+	want := metricdata.Metrics{
+		Name: "fast",
+		Data: metricdata.Gauge[int64]{
+			DataPoints: []metricdata.DataPoint[int64]{{Value: 1}},
+		},
+	}
+	collect := func() []error {
+		mu.Lock()
+		errs = nil
+		mu.Unlock()
+
+		m, err := rdr.Collect(context.Background())
+		require.NoError(t, err)
+		require.Len(t, m.ScopeMetrics, 1)
+		require.Len(t, m.ScopeMetrics[0].Metrics, 1, "late observation collected")
+		metricdatatest.AssertEqual(t, want, m.ScopeMetrics[0].Metrics[0], metricdatatest.IgnoreTimestamp())
+
+		mu.Lock()
+		defer mu.Unlock()
+		return errs
+	}
+
+	got := collect()
+	require.Len(t, got, 1)
+	assert.ErrorIs(t, got[0], errCallbackTimeout)
+
+	// The abandoned run has not returned, the callback is skipped.
+	got = collect()
+	require.Len(t, got, 1)
+	assert.ErrorIs(t, got[0], errCallbackRunning)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&runs), "abandoned callback run again")
+
+	close(release)
+	<-lateDone
+	// Once the abandoned run returns the callback is run again and nothing it
+	// observed late is collected.
+	for i := 0; len(collect()) > 0; i++ {
+		require.Less(t, i, 1000, "callback not run after abandoned run returned")
+		time.Sleep(time.Millisecond)
+	}
+	assert.Greater(t, atomic.LoadInt32(&runs), int32(1))
+}
+
+func TestCallbackConcurrency(t *testing.T) {
+	const n = 3
+	rdr := NewManualReader()
+	mtr := NewMeterProvider(
+		WithReader(rdr),
+		WithCallbackConcurrency(n),
+	).Meter("TestCallbackConcurrency")
+
+	// Each callback blocks until all are running, which can only happen if
+	// they are run concurrently.
+	var running sync.WaitGroup
+	running.Add(n)
+	allRunning := make(chan struct{})
+	go func() {
+		running.Wait()
+		close(allRunning)
+	}()
+
+	for i := 0; i < n; i++ {
+		ctr, err := mtr.AsyncInt64().Counter(fmt.Sprintf("counter%d", i))
+		require.NoError(t, err)
+		_, err = mtr.RegisterCallback([]instrument.Asynchronous{ctr}, func(ctx context.Context, o metric.Observer) {
+			running.Done()
+			select {
+			case <-allRunning:
+				o.ObserveInt64(ctr, 1)
+			case <-ctx.Done():
+			}
+		})
+		require.NoError(t, err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	m, err := rdr.Collect(ctx)
+	require.NoError(t, err, "callbacks not run concurrently")
+	require.Len(t, m.ScopeMetrics, 1)
+	assert.Len(t, m.ScopeMetrics[0].Metrics, n)
+}
@@ -53,7 +53,7 @@ type Metrics struct {
 }
 
 // Aggregation is the store of data reported by an Instrument.
-// It will be one of: Gauge, Sum, Histogram.
+// It will be one of: Gauge, Sum, Histogram, ExponentialHistogram, Summary.
 type Aggregation interface {
 	privateAggregation()
 }
@@ -90,6 +90,8 @@ type DataPoint[N int64 | float64] struct {
 	Time time.Time `json:",omitempty"`
 	// Value is the value of this data point.
 	Value N
+	// Exemplars is the sampled Exemplars collected during the timeseries.
+	Exemplars []Exemplar[N] `json:",omitempty"`
 }
 
 // Histogram represents the histogram of all measurements of values from an instrument.
@@ -127,4 +129,131 @@ type HistogramDataPoint struct {
 	Max *float64 `json:",omitempty"`
 	// Sum is the sum of the values recorded.
 	Sum float64
+
+	// Exemplars is the sampled Exemplars collected during the timeseries.
+	Exemplars []Exemplar[float64] `json:",omitempty"`
+}
+
+// ExponentialHistogram represents the histogram of all measurements of values
+// from an instrument using exponentially sized buckets.
+type ExponentialHistogram struct {
+	// DataPoints reprents individual aggregated measurements with unique Attributes.
+	DataPoints []ExponentialHistogramDataPoint
+	// Temporality describes if the aggregation is reported as the change from the
+	// last report time, or the cumulative changes since a fixed start time.
+	Temporality Temporality
+}
+
+func (ExponentialHistogram) privateAggregation() {}
+
+// ExponentialHistogramDataPoint is a single exponential histogram data point
+// in a timeseries.
+type ExponentialHistogramDataPoint struct {
+	// Attributes is the set of key value pairs that uniquely identify the
+	// timeseries.
+	Attributes attribute.Set
+	// StartTime is when the timeseries was started.
+	StartTime time.Time
+	// Time is the time when the timeseries was recorded.
+	Time time.Time
+
+	// Count is the number of updates this histogram has been calculated with.
+	Count uint64
+	// Min is the minimum value recorded. (optional)
+	Min *float64 `json:",omitempty"`
+	// Max is the maximum value recorded. (optional)
+	Max *float64 `json:",omitempty"`
+	// Sum is the sum of the values recorded.
+	Sum float64
+
+	// Scale is the resolution of the histogram. The base of the bucket
+	// boundaries is 2^(2^-Scale).
+	Scale int32
+	// ZeroCount is the number of values whose absolute value is zero.
+	ZeroCount uint64
+
+	// PositiveBucket is range of positive value bucket counts.
+	PositiveBucket ExponentialBucket
+	// NegativeBucket is range of negative value bucket counts.
+	NegativeBucket ExponentialBucket
+
+	// Exemplars is the sampled Exemplars collected during the timeseries.
+	Exemplars []Exemplar[float64] `json:",omitempty"`
+}
+
+// ExponentialBucket is a set of bucket counts, encoded in a contiguous array
+// of counts.
+type ExponentialBucket struct {
+	// Offset is the bucket index of the first entry in the Counts slice.
+	Offset int32
+	// Counts is an slice where Counts[i] carries the count of the bucket at
+	// index (Offset+i). Counts[i] is the count of values greater than
+	// base^(Offset+i) and less than or equal to base^(Offset+i+1).
+	Counts []uint64
+}
+
+// Summary metric data are used to convey quantile summaries, a Prometheus
+// (see: https://prometheus.io/docs/concepts/metric_types/#summary) and
+// OpenCensus data type.
+//
+// These data points cannot always be merged in a meaningful way. The Summary
+// type is only used by bridges from other instrumentation libraries, and
+// cannot be produced by OpenTelemetry instrumentation.
+type Summary struct {
+	// DataPoints reprents individual aggregated measurements with unique Attributes.
+	DataPoints []SummaryDataPoint
+}
+
+func (Summary) privateAggregation() {}
+
+// SummaryDataPoint is a single data point in a timeseries that describes the
+// time-varying values of a Summary metric.
+type SummaryDataPoint struct {
+	// Attributes is the set of key value pairs that uniquely identify the
+	// timeseries.
+	Attributes attribute.Set
+	// StartTime is when the timeseries was started.
+	StartTime time.Time
+	// Time is the time when the timeseries was recorded.
+	Time time.Time
+
+	// Count is the number of updates this summary has been calculated with.
+	Count uint64
+	// Sum is the sum of the values recorded.
+	Sum float64
+
+	// QuantileValues are the values of the summary at specific quantiles.
+	// (optional)
+	QuantileValues []QuantileValue `json:",omitempty"`
+}
+
+// QuantileValue is the value at a given quantile of a summary.
+type QuantileValue struct {
+	// Quantile is the quantile of this value.
+	//
+	// Must be in the interval [0.0, 1.0].
+	Quantile float64
+	// Value is the value at the given quantile of a summary.
+	//
+	// Quantile values must NOT be negative.
+	Value float64
+}
+
+// Exemplar is a measurement sampled from a timeseries providing a typical
+// example.
+type Exemplar[N int64 | float64] struct {
+	// FilteredAttributes are the attributes recorded with the measurement but
+	// filtered out of the timeseries' aggregated data.
+	FilteredAttributes []attribute.KeyValue `json:",omitempty"`
+	// Time is the time when the measurement was recorded.
+	Time time.Time
+	// Value is the measured value.
+	Value N
+	// SpanID is the ID of the span that was active during the measurement. If
+	// no span was active or the span was not sampled this will be empty.
+	SpanID []byte `json:",omitempty"`
+	// TraceID is the ID of the trace the active span belonged to during the
+	// measurement. If no span was active or the span was not sampled this will
+	// be empty.
+	TraceID []byte `json:",omitempty"`
 }
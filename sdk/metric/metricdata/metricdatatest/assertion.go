@@ -27,15 +27,22 @@ import (
 type Datatypes interface {
 	metricdata.DataPoint[float64] |
 		metricdata.DataPoint[int64] |
+		metricdata.Exemplar[float64] |
+		metricdata.Exemplar[int64] |
+		metricdata.ExponentialHistogram |
+		metricdata.ExponentialHistogramDataPoint |
 		metricdata.Gauge[float64] |
 		metricdata.Gauge[int64] |
 		metricdata.Histogram |
 		metricdata.HistogramDataPoint |
 		metricdata.Metrics |
+		metricdata.QuantileValue |
 		metricdata.ResourceMetrics |
 		metricdata.ScopeMetrics |
 		metricdata.Sum[float64] |
-		metricdata.Sum[int64]
+		metricdata.Sum[int64] |
+		metricdata.Summary |
+		metricdata.SummaryDataPoint
 
 	// Interface types are not allowed in union types, therefore the
 	// Aggregation and Value type from metricdata are not included here.
@@ -43,6 +50,7 @@ type Datatypes interface {
 
 type config struct {
 	ignoreTimestamp bool
+	ignoreExemplars bool
 }
 
 // Option allows for fine grain control over how AssertEqual operates.
@@ -64,6 +72,14 @@ func IgnoreTimestamp() Option {
 	})
 }
 
+// IgnoreExemplars disables checking if Exemplars are different.
+func IgnoreExemplars() Option {
+	return fnOption(func(cfg config) config {
+		cfg.ignoreExemplars = true
+		return cfg
+	})
+}
+
 // AssertEqual asserts that the two concrete data-types from the metricdata
 // package are equal.
 func AssertEqual[T Datatypes](t *testing.T, expected, actual T, opts ...Option) bool {
@@ -83,6 +99,14 @@ func AssertEqual[T Datatypes](t *testing.T, expected, actual T, opts ...Option)
 		r = equalDataPoints(e, aIface.(metricdata.DataPoint[int64]), cfg)
 	case metricdata.DataPoint[float64]:
 		r = equalDataPoints(e, aIface.(metricdata.DataPoint[float64]), cfg)
+	case metricdata.Exemplar[int64]:
+		r = equalExemplars(e, aIface.(metricdata.Exemplar[int64]), cfg)
+	case metricdata.Exemplar[float64]:
+		r = equalExemplars(e, aIface.(metricdata.Exemplar[float64]), cfg)
+	case metricdata.ExponentialHistogram:
+		r = equalExponentialHistograms(e, aIface.(metricdata.ExponentialHistogram), cfg)
+	case metricdata.ExponentialHistogramDataPoint:
+		r = equalExponentialHistogramDataPoints(e, aIface.(metricdata.ExponentialHistogramDataPoint), cfg)
 	case metricdata.Gauge[int64]:
 		r = equalGauges(e, aIface.(metricdata.Gauge[int64]), cfg)
 	case metricdata.Gauge[float64]:
@@ -93,6 +117,8 @@ func AssertEqual[T Datatypes](t *testing.T, expected, actual T, opts ...Option)
 		r = equalHistogramDataPoints(e, aIface.(metricdata.HistogramDataPoint), cfg)
 	case metricdata.Metrics:
 		r = equalMetrics(e, aIface.(metricdata.Metrics), cfg)
+	case metricdata.QuantileValue:
+		r = equalQuantileValue(e, aIface.(metricdata.QuantileValue), cfg)
 	case metricdata.ResourceMetrics:
 		r = equalResourceMetrics(e, aIface.(metricdata.ResourceMetrics), cfg)
 	case metricdata.ScopeMetrics:
@@ -101,6 +127,10 @@ func AssertEqual[T Datatypes](t *testing.T, expected, actual T, opts ...Option)
 		r = equalSums(e, aIface.(metricdata.Sum[int64]), cfg)
 	case metricdata.Sum[float64]:
 		r = equalSums(e, aIface.(metricdata.Sum[float64]), cfg)
+	case metricdata.Summary:
+		r = equalSummary(e, aIface.(metricdata.Summary), cfg)
+	case metricdata.SummaryDataPoint:
+		r = equalSummaryDataPoint(e, aIface.(metricdata.SummaryDataPoint), cfg)
 	default:
 		// We control all types passed to this, panic to signal developers
 		// early they changed things in an incompatible way.
@@ -37,13 +37,17 @@ func TestFailAssertEqual(t *testing.T) {
 	t.Run("ScopeMetrics", testFailDatatype(scopeMetricsA, scopeMetricsB))
 	t.Run("Metrics", testFailDatatype(metricsA, metricsB))
 	t.Run("Histogram", testFailDatatype(histogramA, histogramB))
+	t.Run("ExponentialHistogram", testFailDatatype(exponentialHistogramA, exponentialHistogramB))
 	t.Run("SumInt64", testFailDatatype(sumInt64A, sumInt64B))
 	t.Run("SumFloat64", testFailDatatype(sumFloat64A, sumFloat64B))
 	t.Run("GaugeInt64", testFailDatatype(gaugeInt64A, gaugeInt64B))
 	t.Run("GaugeFloat64", testFailDatatype(gaugeFloat64A, gaugeFloat64B))
 	t.Run("HistogramDataPoint", testFailDatatype(histogramDataPointA, histogramDataPointB))
+	t.Run("ExponentialHistogramDataPoint", testFailDatatype(exponentialHistogramDataPointA, exponentialHistogramDataPointB))
 	t.Run("DataPointInt64", testFailDatatype(dataPointInt64A, dataPointInt64B))
 	t.Run("DataPointFloat64", testFailDatatype(dataPointFloat64A, dataPointFloat64B))
+	t.Run("ExemplarInt64", testFailDatatype(exemplarInt64A, exemplarInt64B))
+	t.Run("ExemplarFloat64", testFailDatatype(exemplarFloat64A, exemplarFloat64B))
 
 }
 
@@ -56,4 +60,5 @@ func TestFailAssertAggregationsEqual(t *testing.T) {
 	AssertAggregationsEqual(t, gaugeInt64A, gaugeInt64B)
 	AssertAggregationsEqual(t, gaugeFloat64A, gaugeFloat64B)
 	AssertAggregationsEqual(t, histogramA, histogramB)
+	AssertAggregationsEqual(t, exponentialHistogramA, exponentialHistogramB)
 }
@@ -36,41 +36,95 @@ var (
 	endA   = startA.Add(time.Second)
 	endB   = startB.Add(time.Second)
 
+	spanIDA  = []byte{0, 0, 0, 0, 0, 0, 0, 1}
+	spanIDB  = []byte{0, 0, 0, 0, 0, 0, 0, 2}
+	traceIDA = []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1}
+	traceIDB = []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2}
+
+	exemplarInt64A = metricdata.Exemplar[int64]{
+		FilteredAttributes: []attribute.KeyValue{attribute.Bool("filtered", true)},
+		Time:               endA,
+		Value:              -10,
+		SpanID:             spanIDA,
+		TraceID:            traceIDA,
+	}
+	exemplarFloat64A = metricdata.Exemplar[float64]{
+		FilteredAttributes: []attribute.KeyValue{attribute.Bool("filtered", true)},
+		Time:               endA,
+		Value:              -10.0,
+		SpanID:             spanIDA,
+		TraceID:            traceIDA,
+	}
+	exemplarInt64B = metricdata.Exemplar[int64]{
+		FilteredAttributes: []attribute.KeyValue{attribute.Bool("filtered", false)},
+		Time:               endB,
+		Value:              12,
+		SpanID:             spanIDB,
+		TraceID:            traceIDB,
+	}
+	exemplarFloat64B = metricdata.Exemplar[float64]{
+		FilteredAttributes: []attribute.KeyValue{attribute.Bool("filtered", false)},
+		Time:               endB,
+		Value:              12.0,
+		SpanID:             spanIDB,
+		TraceID:            traceIDB,
+	}
+	exemplarInt64C = metricdata.Exemplar[int64]{
+		FilteredAttributes: []attribute.KeyValue{attribute.Bool("filtered", true)},
+		Time:               endB,
+		Value:              -10,
+		SpanID:             spanIDA,
+		TraceID:            traceIDA,
+	}
+	exemplarFloat64C = metricdata.Exemplar[float64]{
+		FilteredAttributes: []attribute.KeyValue{attribute.Bool("filtered", true)},
+		Time:               endB,
+		Value:              -10.0,
+		SpanID:             spanIDA,
+		TraceID:            traceIDA,
+	}
+
 	dataPointInt64A = metricdata.DataPoint[int64]{
 		Attributes: attrA,
 		StartTime:  startA,
 		Time:       endA,
 		Value:      -1,
+		Exemplars:  []metricdata.Exemplar[int64]{exemplarInt64A},
 	}
 	dataPointFloat64A = metricdata.DataPoint[float64]{
 		Attributes: attrA,
 		StartTime:  startA,
 		Time:       endA,
 		Value:      -1.0,
+		Exemplars:  []metricdata.Exemplar[float64]{exemplarFloat64A},
 	}
 	dataPointInt64B = metricdata.DataPoint[int64]{
 		Attributes: attrB,
 		StartTime:  startB,
 		Time:       endB,
 		Value:      2,
+		Exemplars:  []metricdata.Exemplar[int64]{exemplarInt64B},
 	}
 	dataPointFloat64B = metricdata.DataPoint[float64]{
 		Attributes: attrB,
 		StartTime:  startB,
 		Time:       endB,
 		Value:      2.0,
+		Exemplars:  []metricdata.Exemplar[float64]{exemplarFloat64B},
 	}
 	dataPointInt64C = metricdata.DataPoint[int64]{
 		Attributes: attrA,
 		StartTime:  startB,
 		Time:       endB,
 		Value:      -1,
+		Exemplars:  []metricdata.Exemplar[int64]{exemplarInt64C},
 	}
 	dataPointFloat64C = metricdata.DataPoint[float64]{
 		Attributes: attrA,
 		StartTime:  startB,
 		Time:       endB,
 		Value:      -1.0,
+		Exemplars:  []metricdata.Exemplar[float64]{exemplarFloat64C},
 	}
 
 	max, min            = 99.0, 3.
@@ -82,6 +136,7 @@ var (
 		Bounds:       []float64{0, 10},
 		BucketCounts: []uint64{1, 1},
 		Sum:          2,
+		Exemplars:    []metricdata.Exemplar[float64]{exemplarFloat64A},
 	}
 	histogramDataPointB = metricdata.HistogramDataPoint{
 		Attributes:   attrB,
@@ -93,6 +148,7 @@ var (
 		Max:          &max,
 		Min:          &min,
 		Sum:          3,
+		Exemplars:    []metricdata.Exemplar[float64]{exemplarFloat64B},
 	}
 	histogramDataPointC = metricdata.HistogramDataPoint{
 		Attributes:   attrA,
@@ -102,6 +158,50 @@ var (
 		Bounds:       []float64{0, 10},
 		BucketCounts: []uint64{1, 1},
 		Sum:          2,
+		Exemplars:    []metricdata.Exemplar[float64]{exemplarFloat64C},
+	}
+
+	exponentialHistogramDataPointA = metricdata.ExponentialHistogramDataPoint{
+		Attributes: attrA,
+		StartTime:  startA,
+		Time:       endA,
+		Count:      2,
+		Sum:        2,
+		Scale:      1,
+		PositiveBucket: metricdata.ExponentialBucket{
+			Offset: 1,
+			Counts: []uint64{1, 1},
+		},
+		Exemplars: []metricdata.Exemplar[float64]{exemplarFloat64A},
+	}
+	exponentialHistogramDataPointB = metricdata.ExponentialHistogramDataPoint{
+		Attributes: attrB,
+		StartTime:  startB,
+		Time:       endB,
+		Count:      3,
+		Max:        &max,
+		Min:        &min,
+		Sum:        3,
+		Scale:      2,
+		ZeroCount:  1,
+		NegativeBucket: metricdata.ExponentialBucket{
+			Offset: -1,
+			Counts: []uint64{1, 1},
+		},
+		Exemplars: []metricdata.Exemplar[float64]{exemplarFloat64B},
+	}
+	exponentialHistogramDataPointC = metricdata.ExponentialHistogramDataPoint{
+		Attributes: attrA,
+		StartTime:  startB,
+		Time:       endB,
+		Count:      2,
+		Sum:        2,
+		Scale:      1,
+		PositiveBucket: metricdata.ExponentialBucket{
+			Offset: 1,
+			Counts: []uint64{1, 1},
+		},
+		Exemplars: []metricdata.Exemplar[float64]{exemplarFloat64C},
 	}
 
 	gaugeInt64A = metricdata.Gauge[int64]{
@@ -167,6 +267,61 @@ var (
 		DataPoints:  []metricdata.HistogramDataPoint{histogramDataPointC},
 	}
 
+	exponentialHistogramA = metricdata.ExponentialHistogram{
+		Temporality: metricdata.CumulativeTemporality,
+		DataPoints:  []metricdata.ExponentialHistogramDataPoint{exponentialHistogramDataPointA},
+	}
+	exponentialHistogramB = metricdata.ExponentialHistogram{
+		Temporality: metricdata.DeltaTemporality,
+		DataPoints:  []metricdata.ExponentialHistogramDataPoint{exponentialHistogramDataPointB},
+	}
+	exponentialHistogramC = metricdata.ExponentialHistogram{
+		Temporality: metricdata.CumulativeTemporality,
+		DataPoints:  []metricdata.ExponentialHistogramDataPoint{exponentialHistogramDataPointC},
+	}
+
+	quantileValueA = metricdata.QuantileValue{
+		Quantile: 0.0,
+		Value:    0.1,
+	}
+	quantileValueB = metricdata.QuantileValue{
+		Quantile: 0.1,
+		Value:    0.2,
+	}
+	summaryDataPointA = metricdata.SummaryDataPoint{
+		Attributes:     attrA,
+		StartTime:      startA,
+		Time:           endA,
+		Count:          2,
+		Sum:            3,
+		QuantileValues: []metricdata.QuantileValue{quantileValueA},
+	}
+	summaryDataPointB = metricdata.SummaryDataPoint{
+		Attributes:     attrB,
+		StartTime:      startB,
+		Time:           endB,
+		Count:          3,
+		QuantileValues: []metricdata.QuantileValue{quantileValueB},
+	}
+	summaryDataPointC = metricdata.SummaryDataPoint{
+		Attributes:     attrA,
+		StartTime:      startB,
+		Time:           endB,
+		Count:          2,
+		Sum:            3,
+		QuantileValues: []metricdata.QuantileValue{quantileValueA},
+	}
+
+	summaryA = metricdata.Summary{
+		DataPoints: []metricdata.SummaryDataPoint{summaryDataPointA},
+	}
+	summaryB = metricdata.Summary{
+		DataPoints: []metricdata.SummaryDataPoint{summaryDataPointB},
+	}
+	summaryC = metricdata.Summary{
+		DataPoints: []metricdata.SummaryDataPoint{summaryDataPointC},
+	}
+
 	metricsA = metricdata.Metrics{
 		Name:        "A",
 		Description: "A desc",
@@ -240,13 +395,20 @@ func TestAssertEqual(t *testing.T) {
 	t.Run("ScopeMetrics", testDatatype(scopeMetricsA, scopeMetricsB, equalScopeMetrics))
 	t.Run("Metrics", testDatatype(metricsA, metricsB, equalMetrics))
 	t.Run("Histogram", testDatatype(histogramA, histogramB, equalHistograms))
+	t.Run("ExponentialHistogram", testDatatype(exponentialHistogramA, exponentialHistogramB, equalExponentialHistograms))
 	t.Run("SumInt64", testDatatype(sumInt64A, sumInt64B, equalSums[int64]))
 	t.Run("SumFloat64", testDatatype(sumFloat64A, sumFloat64B, equalSums[float64]))
 	t.Run("GaugeInt64", testDatatype(gaugeInt64A, gaugeInt64B, equalGauges[int64]))
 	t.Run("GaugeFloat64", testDatatype(gaugeFloat64A, gaugeFloat64B, equalGauges[float64]))
 	t.Run("HistogramDataPoint", testDatatype(histogramDataPointA, histogramDataPointB, equalHistogramDataPoints))
+	t.Run("ExponentialHistogramDataPoint", testDatatype(exponentialHistogramDataPointA, exponentialHistogramDataPointB, equalExponentialHistogramDataPoints))
 	t.Run("DataPointInt64", testDatatype(dataPointInt64A, dataPointInt64B, equalDataPoints[int64]))
 	t.Run("DataPointFloat64", testDatatype(dataPointFloat64A, dataPointFloat64B, equalDataPoints[float64]))
+	t.Run("ExemplarInt64", testDatatype(exemplarInt64A, exemplarInt64B, equalExemplars[int64]))
+	t.Run("ExemplarFloat64", testDatatype(exemplarFloat64A, exemplarFloat64B, equalExemplars[float64]))
+	t.Run("Summary", testDatatype(summaryA, summaryB, equalSummary))
+	t.Run("SummaryDataPoint", testDatatype(summaryDataPointA, summaryDataPointB, equalSummaryDataPoint))
+	t.Run("QuantileValues", testDatatype(quantileValueA, quantileValueB, equalQuantileValue))
 }
 
 func TestAssertEqualIgnoreTime(t *testing.T) {
@@ -254,13 +416,45 @@ func TestAssertEqualIgnoreTime(t *testing.T) {
 	t.Run("ScopeMetrics", testDatatypeIgnoreTime(scopeMetricsA, scopeMetricsC, equalScopeMetrics))
 	t.Run("Metrics", testDatatypeIgnoreTime(metricsA, metricsC, equalMetrics))
 	t.Run("Histogram", testDatatypeIgnoreTime(histogramA, histogramC, equalHistograms))
+	t.Run("ExponentialHistogram", testDatatypeIgnoreTime(exponentialHistogramA, exponentialHistogramC, equalExponentialHistograms))
 	t.Run("SumInt64", testDatatypeIgnoreTime(sumInt64A, sumInt64C, equalSums[int64]))
 	t.Run("SumFloat64", testDatatypeIgnoreTime(sumFloat64A, sumFloat64C, equalSums[float64]))
 	t.Run("GaugeInt64", testDatatypeIgnoreTime(gaugeInt64A, gaugeInt64C, equalGauges[int64]))
 	t.Run("GaugeFloat64", testDatatypeIgnoreTime(gaugeFloat64A, gaugeFloat64C, equalGauges[float64]))
 	t.Run("HistogramDataPoint", testDatatypeIgnoreTime(histogramDataPointA, histogramDataPointC, equalHistogramDataPoints))
+	t.Run("ExponentialHistogramDataPoint", testDatatypeIgnoreTime(exponentialHistogramDataPointA, exponentialHistogramDataPointC, equalExponentialHistogramDataPoints))
 	t.Run("DataPointInt64", testDatatypeIgnoreTime(dataPointInt64A, dataPointInt64C, equalDataPoints[int64]))
 	t.Run("DataPointFloat64", testDatatypeIgnoreTime(dataPointFloat64A, dataPointFloat64C, equalDataPoints[float64]))
+	t.Run("ExemplarInt64", testDatatypeIgnoreTime(exemplarInt64A, exemplarInt64C, equalExemplars[int64]))
+	t.Run("ExemplarFloat64", testDatatypeIgnoreTime(exemplarFloat64A, exemplarFloat64C, equalExemplars[float64]))
+	t.Run("Summary", testDatatypeIgnoreTime(summaryA, summaryC, equalSummary))
+	t.Run("SummaryDataPoint", testDatatypeIgnoreTime(summaryDataPointA, summaryDataPointC, equalSummaryDataPoint))
+}
+
+func TestAssertEqualIgnoreExemplars(t *testing.T) {
+	cfg := config{ignoreExemplars: true}
+
+	dpInt64 := dataPointInt64A
+	dpInt64.Exemplars = []metricdata.Exemplar[int64]{exemplarInt64B}
+	r := equalDataPoints(dataPointInt64A, dpInt64, cfg)
+	assert.Len(t, r, 0, "DataPointInt64")
+
+	dpFloat64 := dataPointFloat64A
+	dpFloat64.Exemplars = []metricdata.Exemplar[float64]{exemplarFloat64B}
+	r = equalDataPoints(dataPointFloat64A, dpFloat64, cfg)
+	assert.Len(t, r, 0, "DataPointFloat64")
+
+	hdp := histogramDataPointA
+	hdp.Exemplars = []metricdata.Exemplar[float64]{exemplarFloat64B}
+	r = equalHistogramDataPoints(histogramDataPointA, hdp, cfg)
+	assert.Len(t, r, 0, "HistogramDataPoint")
+
+	ehdp := exponentialHistogramDataPointA
+	ehdp.Exemplars = []metricdata.Exemplar[float64]{exemplarFloat64B}
+	r = equalExponentialHistogramDataPoints(exponentialHistogramDataPointA, ehdp, cfg)
+	assert.Len(t, r, 0, "ExponentialHistogramDataPoint")
+
+	AssertEqual(t, dataPointInt64A, dpInt64, IgnoreExemplars())
 }
 
 type unknownAggregation struct {
@@ -274,6 +468,8 @@ func TestAssertAggregationsEqual(t *testing.T) {
 	AssertAggregationsEqual(t, gaugeInt64A, gaugeInt64A)
 	AssertAggregationsEqual(t, gaugeFloat64A, gaugeFloat64A)
 	AssertAggregationsEqual(t, histogramA, histogramA)
+	AssertAggregationsEqual(t, exponentialHistogramA, exponentialHistogramA)
+	AssertAggregationsEqual(t, summaryA, summaryA)
 
 	r := equalAggregations(sumInt64A, nil, config{})
 	assert.Len(t, r, 1, "should return nil comparison mismatch only")
@@ -313,4 +509,16 @@ func TestAssertAggregationsEqual(t *testing.T) {
 
 	r = equalAggregations(histogramA, histogramC, config{ignoreTimestamp: true})
 	assert.Equalf(t, len(r), 0, "%v == %v", histogramA, histogramC)
+
+	r = equalAggregations(exponentialHistogramA, exponentialHistogramB, config{})
+	assert.Greaterf(t, len(r), 0, "%v == %v", exponentialHistogramA, exponentialHistogramB)
+
+	r = equalAggregations(exponentialHistogramA, exponentialHistogramC, config{ignoreTimestamp: true})
+	assert.Equalf(t, len(r), 0, "%v == %v", exponentialHistogramA, exponentialHistogramC)
+
+	r = equalAggregations(summaryA, summaryB, config{})
+	assert.Greaterf(t, len(r), 0, "%v == %v", summaryA, summaryB)
+
+	r = equalAggregations(summaryA, summaryC, config{ignoreTimestamp: true})
+	assert.Equalf(t, len(r), 0, "%v == %v", summaryA, summaryC)
 }
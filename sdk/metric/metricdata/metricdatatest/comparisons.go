@@ -137,6 +137,18 @@ func equalAggregations(a, b metricdata.Aggregation, cfg config) (reasons []strin
 			reasons = append(reasons, "Histogram not equal:")
 			reasons = append(reasons, r...)
 		}
+	case metricdata.ExponentialHistogram:
+		r := equalExponentialHistograms(v, b.(metricdata.ExponentialHistogram), cfg)
+		if len(r) > 0 {
+			reasons = append(reasons, "ExponentialHistogram not equal:")
+			reasons = append(reasons, r...)
+		}
+	case metricdata.Summary:
+		r := equalSummary(v, b.(metricdata.Summary), cfg)
+		if len(r) > 0 {
+			reasons = append(reasons, "Summary not equal:")
+			reasons = append(reasons, r...)
+		}
 	default:
 		reasons = append(reasons, fmt.Sprintf("Aggregation of unknown types %T", a))
 	}
@@ -237,6 +249,20 @@ func equalDataPoints[N int64 | float64](a, b metricdata.DataPoint[N], cfg config
 	if a.Value != b.Value {
 		reasons = append(reasons, notEqualStr("Value", a.Value, b.Value))
 	}
+
+	if !cfg.ignoreExemplars {
+		r := compareDiff(diffSlices(
+			a.Exemplars,
+			b.Exemplars,
+			func(a, b metricdata.Exemplar[N]) bool {
+				r := equalExemplars(a, b, cfg)
+				return len(r) == 0
+			},
+		))
+		if r != "" {
+			reasons = append(reasons, fmt.Sprintf("Exemplars not equal:\n%s", r))
+		}
+	}
 	return reasons
 }
 
@@ -276,6 +302,224 @@ func equalHistogramDataPoints(a, b metricdata.HistogramDataPoint, cfg config) (r
 	if a.Sum != b.Sum {
 		reasons = append(reasons, notEqualStr("Sum", a.Sum, b.Sum))
 	}
+
+	if !cfg.ignoreExemplars {
+		r := compareDiff(diffSlices(
+			a.Exemplars,
+			b.Exemplars,
+			func(a, b metricdata.Exemplar[float64]) bool {
+				r := equalExemplars(a, b, cfg)
+				return len(r) == 0
+			},
+		))
+		if r != "" {
+			reasons = append(reasons, fmt.Sprintf("Exemplars not equal:\n%s", r))
+		}
+	}
+	return reasons
+}
+
+// equalExponentialHistograms returns reasons ExponentialHistograms are not
+// equal. If they are equal, the returned reasons will be empty.
+//
+// The DataPoints each ExponentialHistogram contains are compared based on
+// containing the same ExponentialHistogramDataPoint, not the order they are
+// stored in.
+func equalExponentialHistograms(a, b metricdata.ExponentialHistogram, cfg config) (reasons []string) {
+	if a.Temporality != b.Temporality {
+		reasons = append(reasons, notEqualStr("Temporality", a.Temporality, b.Temporality))
+	}
+
+	r := compareDiff(diffSlices(
+		a.DataPoints,
+		b.DataPoints,
+		func(a, b metricdata.ExponentialHistogramDataPoint) bool {
+			r := equalExponentialHistogramDataPoints(a, b, cfg)
+			return len(r) == 0
+		},
+	))
+	if r != "" {
+		reasons = append(reasons, fmt.Sprintf("ExponentialHistogram DataPoints not equal:\n%s", r))
+	}
+	return reasons
+}
+
+// equalExponentialHistogramDataPoints returns reasons
+// ExponentialHistogramDataPoints are not equal. If they are equal, the
+// returned reasons will be empty.
+func equalExponentialHistogramDataPoints(a, b metricdata.ExponentialHistogramDataPoint, cfg config) (reasons []string) { // nolint: revive // Intentional internal control flag
+	if !a.Attributes.Equals(&b.Attributes) {
+		reasons = append(reasons, notEqualStr(
+			"Attributes",
+			a.Attributes.Encoded(attribute.DefaultEncoder()),
+			b.Attributes.Encoded(attribute.DefaultEncoder()),
+		))
+	}
+	if !cfg.ignoreTimestamp {
+		if !a.StartTime.Equal(b.StartTime) {
+			reasons = append(reasons, notEqualStr("StartTime", a.StartTime.UnixNano(), b.StartTime.UnixNano()))
+		}
+		if !a.Time.Equal(b.Time) {
+			reasons = append(reasons, notEqualStr("Time", a.Time.UnixNano(), b.Time.UnixNano()))
+		}
+	}
+	if a.Count != b.Count {
+		reasons = append(reasons, notEqualStr("Count", a.Count, b.Count))
+	}
+	if !equalPtrValues(a.Min, b.Min) {
+		reasons = append(reasons, notEqualStr("Min", a.Min, b.Min))
+	}
+	if !equalPtrValues(a.Max, b.Max) {
+		reasons = append(reasons, notEqualStr("Max", a.Max, b.Max))
+	}
+	if a.Sum != b.Sum {
+		reasons = append(reasons, notEqualStr("Sum", a.Sum, b.Sum))
+	}
+	if a.Scale != b.Scale {
+		reasons = append(reasons, notEqualStr("Scale", a.Scale, b.Scale))
+	}
+	if a.ZeroCount != b.ZeroCount {
+		reasons = append(reasons, notEqualStr("ZeroCount", a.ZeroCount, b.ZeroCount))
+	}
+
+	r := equalExponentialBuckets(a.PositiveBucket, b.PositiveBucket)
+	if len(r) > 0 {
+		reasons = append(reasons, "PositiveBucket not equal:")
+		reasons = append(reasons, r...)
+	}
+	r = equalExponentialBuckets(a.NegativeBucket, b.NegativeBucket)
+	if len(r) > 0 {
+		reasons = append(reasons, "NegativeBucket not equal:")
+		reasons = append(reasons, r...)
+	}
+
+	if !cfg.ignoreExemplars {
+		r := compareDiff(diffSlices(
+			a.Exemplars,
+			b.Exemplars,
+			func(a, b metricdata.Exemplar[float64]) bool {
+				r := equalExemplars(a, b, cfg)
+				return len(r) == 0
+			},
+		))
+		if r != "" {
+			reasons = append(reasons, fmt.Sprintf("Exemplars not equal:\n%s", r))
+		}
+	}
+	return reasons
+}
+
+// equalExponentialBuckets returns reasons ExponentialBuckets are not equal.
+// If they are equal, the returned reasons will be empty.
+func equalExponentialBuckets(a, b metricdata.ExponentialBucket) (reasons []string) {
+	if a.Offset != b.Offset {
+		reasons = append(reasons, notEqualStr("Offset", a.Offset, b.Offset))
+	}
+	if !equalSlices(a.Counts, b.Counts) {
+		reasons = append(reasons, notEqualStr("Counts", a.Counts, b.Counts))
+	}
+	return reasons
+}
+
+// equalSummary returns reasons Summaries are not equal. If they are equal,
+// the returned reasons will be empty.
+//
+// The DataPoints each Summary contains are compared based on containing the
+// same SummaryDataPoint, not the order they are stored in.
+func equalSummary(a, b metricdata.Summary, cfg config) (reasons []string) {
+	r := compareDiff(diffSlices(
+		a.DataPoints,
+		b.DataPoints,
+		func(a, b metricdata.SummaryDataPoint) bool {
+			r := equalSummaryDataPoint(a, b, cfg)
+			return len(r) == 0
+		},
+	))
+	if r != "" {
+		reasons = append(reasons, fmt.Sprintf("Summary DataPoints not equal:\n%s", r))
+	}
+	return reasons
+}
+
+// equalSummaryDataPoint returns reasons SummaryDataPoints are not equal. If
+// they are equal, the returned reasons will be empty.
+func equalSummaryDataPoint(a, b metricdata.SummaryDataPoint, cfg config) (reasons []string) { // nolint: revive // Intentional internal control flag
+	if !a.Attributes.Equals(&b.Attributes) {
+		reasons = append(reasons, notEqualStr(
+			"Attributes",
+			a.Attributes.Encoded(attribute.DefaultEncoder()),
+			b.Attributes.Encoded(attribute.DefaultEncoder()),
+		))
+	}
+	if !cfg.ignoreTimestamp {
+		if !a.StartTime.Equal(b.StartTime) {
+			reasons = append(reasons, notEqualStr("StartTime", a.StartTime.UnixNano(), b.StartTime.UnixNano()))
+		}
+		if !a.Time.Equal(b.Time) {
+			reasons = append(reasons, notEqualStr("Time", a.Time.UnixNano(), b.Time.UnixNano()))
+		}
+	}
+	if a.Count != b.Count {
+		reasons = append(reasons, notEqualStr("Count", a.Count, b.Count))
+	}
+	if a.Sum != b.Sum {
+		reasons = append(reasons, notEqualStr("Sum", a.Sum, b.Sum))
+	}
+	r := compareDiff(diffSlices(
+		a.QuantileValues,
+		b.QuantileValues,
+		func(a, b metricdata.QuantileValue) bool {
+			r := equalQuantileValue(a, b, cfg)
+			return len(r) == 0
+		},
+	))
+	if r != "" {
+		reasons = append(reasons, fmt.Sprintf("QuantileValues not equal:\n%s", r))
+	}
+	return reasons
+}
+
+// equalQuantileValue returns reasons QuantileValues are not equal. If they
+// are equal, the returned reasons will be empty.
+func equalQuantileValue(a, b metricdata.QuantileValue, _ config) (reasons []string) {
+	if a.Quantile != b.Quantile {
+		reasons = append(reasons, notEqualStr("Quantile", a.Quantile, b.Quantile))
+	}
+	if a.Value != b.Value {
+		reasons = append(reasons, notEqualStr("Value", a.Value, b.Value))
+	}
+	return reasons
+}
+
+// equalExemplars returns reasons Exemplars are not equal. If they are
+// equal, the returned reasons will be empty.
+//
+// The FilteredAttributes each Exemplar contains are compared based on
+// containing the same attributes, not the order they are stored in.
+func equalExemplars[N int64 | float64](a, b metricdata.Exemplar[N], cfg config) (reasons []string) {
+	aAttr := attribute.NewSet(a.FilteredAttributes...)
+	bAttr := attribute.NewSet(b.FilteredAttributes...)
+	if !aAttr.Equals(&bAttr) {
+		reasons = append(reasons, notEqualStr(
+			"FilteredAttributes",
+			aAttr.Encoded(attribute.DefaultEncoder()),
+			bAttr.Encoded(attribute.DefaultEncoder()),
+		))
+	}
+	if !cfg.ignoreTimestamp {
+		if !a.Time.Equal(b.Time) {
+			reasons = append(reasons, notEqualStr("Time", a.Time.UnixNano(), b.Time.UnixNano()))
+		}
+	}
+	if a.Value != b.Value {
+		reasons = append(reasons, notEqualStr("Value", a.Value, b.Value))
+	}
+	if !equalSlices(a.SpanID, b.SpanID) {
+		reasons = append(reasons, notEqualStr("SpanID", a.SpanID, b.SpanID))
+	}
+	if !equalSlices(a.TraceID, b.TraceID) {
+		reasons = append(reasons, notEqualStr("TraceID", a.TraceID, b.TraceID))
+	}
 	return reasons
 }
 
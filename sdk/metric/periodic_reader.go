@@ -23,6 +23,10 @@ import (
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/internal/global"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/instrument"
+	"go.opentelemetry.io/otel/metric/instrument/syncfloat64"
+	"go.opentelemetry.io/otel/metric/unit"
 	"go.opentelemetry.io/otel/sdk/metric/aggregation"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 	"go.opentelemetry.io/otel/sdk/metric/view"
@@ -36,16 +40,23 @@ const (
 
 // periodicReaderConfig contains configuration options for a PeriodicReader.
 type periodicReaderConfig struct {
-	interval time.Duration
-	timeout  time.Duration
+	interval  time.Duration
+	timeout   time.Duration
+	producers []Producer
+	// meterProvider is used to report the operation of the PeriodicReader.
+	// If nil, no metrics are reported.
+	meterProvider metric.MeterProvider
+	// maxExportBatchSize is the maximum number of data points exported in a
+	// single call to the exporter. If not positive, there is no limit.
+	maxExportBatchSize int
 }
 
 // newPeriodicReaderConfig returns a periodicReaderConfig configured with
 // options.
 func newPeriodicReaderConfig(options []PeriodicReaderOption) periodicReaderConfig {
 	c := periodicReaderConfig{
-		interval: defaultInterval,
-		timeout:  defaultTimeout,
+		interval: envDuration(envInterval, defaultInterval),
+		timeout:  envDuration(envTimeout, defaultTimeout),
 	}
 	for _, o := range options {
 		c = o.applyPeriodic(c)
@@ -69,8 +80,11 @@ func (o periodicReaderOptionFunc) applyPeriodic(conf periodicReaderConfig) perio
 // WithTimeout configures the time a PeriodicReader waits for an export to
 // complete before canceling it.
 //
-// If this option is not used or d is less than or equal to zero, 30 seconds
-// is used as the default.
+// If the OTEL_METRIC_EXPORT_TIMEOUT environment variable is set, and this
+// option is not passed, its value (in milliseconds) is used.
+//
+// If this option is not used or d is less than or equal to zero, and the
+// environment variable is not set, 30 seconds is used as the default.
 func WithTimeout(d time.Duration) PeriodicReaderOption {
 	return periodicReaderOptionFunc(func(conf periodicReaderConfig) periodicReaderConfig {
 		if d <= 0 {
@@ -84,8 +98,11 @@ func WithTimeout(d time.Duration) PeriodicReaderOption {
 // WithInterval configures the intervening time between exports for a
 // PeriodicReader.
 //
-// If this option is not used or d is less than or equal to zero, 60 seconds
-// is used as the default.
+// If the OTEL_METRIC_EXPORT_INTERVAL environment variable is set, and this
+// option is not passed, its value (in milliseconds) is used.
+//
+// If this option is not used or d is less than or equal to zero, and the
+// environment variable is not set, 60 seconds is used as the default.
 func WithInterval(d time.Duration) PeriodicReaderOption {
 	return periodicReaderOptionFunc(func(conf periodicReaderConfig) periodicReaderConfig {
 		if d <= 0 {
@@ -96,11 +113,43 @@ func WithInterval(d time.Duration) PeriodicReaderOption {
 	})
 }
 
+// WithMeterProvider configures the MeterProvider a PeriodicReader uses to
+// report the duration of its collections.
+//
+// The duration is recorded after each collection completes. If mp is the
+// MeterProvider the PeriodicReader is registered with, the duration of a
+// collection is reported by the next one.
+//
+// By default, if this option is not used, no metrics are reported.
+func WithMeterProvider(mp metric.MeterProvider) PeriodicReaderOption {
+	return periodicReaderOptionFunc(func(conf periodicReaderConfig) periodicReaderConfig {
+		conf.meterProvider = mp
+		return conf
+	})
+}
+
+// WithMaxExportBatchSize configures the maximum number of data points a
+// PeriodicReader passes to its exporter in a single export. Metric data
+// collected with more data points than n is split into multiple exports, each
+// retaining the Resource and Scope grouping of the collected data. All the
+// exports of a single collection share the timeout set with WithTimeout.
+//
+// By default, if this option is not used or n is less than or equal to zero,
+// all the metric data of a collection is exported at once.
+func WithMaxExportBatchSize(n int) PeriodicReaderOption {
+	return periodicReaderOptionFunc(func(conf periodicReaderConfig) periodicReaderConfig {
+		conf.maxExportBatchSize = n
+		return conf
+	})
+}
+
 // NewPeriodicReader returns a Reader that collects and exports metric data to
 // the exporter at a defined interval. By default, the returned Reader will
 // collect and export data every 60 seconds, and will cancel export attempts
-// that exceed 30 seconds. The export time is not counted towards the interval
-// between attempts.
+// that exceed 30 seconds. These defaults can be changed with the
+// OTEL_METRIC_EXPORT_INTERVAL and OTEL_METRIC_EXPORT_TIMEOUT environment
+// variables. The export time is not counted towards the interval between
+// attempts.
 //
 // The Collect method of the returned Reader continues to gather and return
 // metric data to the user. It will not automatically send that data to the
@@ -109,11 +158,14 @@ func NewPeriodicReader(exporter Exporter, options ...PeriodicReaderOption) Reade
 	conf := newPeriodicReaderConfig(options)
 	ctx, cancel := context.WithCancel(context.Background())
 	r := &periodicReader{
-		timeout:  conf.timeout,
-		exporter: exporter,
-		flushCh:  make(chan chan error),
-		cancel:   cancel,
-		done:     make(chan struct{}),
+		timeout:      conf.timeout,
+		exporter:     exporter,
+		producers:    conf.producers,
+		maxBatchSize: conf.maxExportBatchSize,
+		duration:     newCollectDuration(conf.meterProvider),
+		flushCh:      make(chan chan error),
+		cancel:       cancel,
+		done:         make(chan struct{}),
 	}
 
 	go func() {
@@ -129,9 +181,16 @@ func NewPeriodicReader(exporter Exporter, options ...PeriodicReaderOption) Reade
 type periodicReader struct {
 	producer atomic.Value
 
-	timeout  time.Duration
-	exporter Exporter
-	flushCh  chan chan error
+	timeout   time.Duration
+	exporter  Exporter
+	producers []Producer
+	flushCh   chan chan error
+	// maxBatchSize is the maximum number of data points exported at once. If
+	// not positive, there is no limit.
+	maxBatchSize int
+	// duration records the duration of collections. If nil, nothing is
+	// recorded.
+	duration syncfloat64.Histogram
 
 	done         chan struct{}
 	cancel       context.CancelFunc
@@ -166,6 +225,25 @@ func (r *periodicReader) run(ctx context.Context, interval time.Duration) {
 	}
 }
 
+// newCollectDuration returns the Histogram a periodicReader records the
+// duration of its collections with. If mp is nil or the Histogram cannot be
+// created, nil is returned.
+func newCollectDuration(mp metric.MeterProvider) syncfloat64.Histogram {
+	if mp == nil {
+		return nil
+	}
+	h, err := mp.Meter("go.opentelemetry.io/otel/sdk/metric").SyncFloat64().Histogram(
+		"otel.sdk.metric_reader.collection.duration",
+		instrument.WithUnit(unit.Milliseconds),
+		instrument.WithDescription("The duration of metric collections made by a periodic reader"),
+	)
+	if err != nil {
+		otel.Handle(err)
+		return nil
+	}
+	return h
+}
+
 // register registers p as the producer of this reader.
 func (r *periodicReader) register(p producer) {
 	// Only register once. If producer is already set, do nothing.
@@ -188,7 +266,12 @@ func (r *periodicReader) aggregation(kind view.InstrumentKind) aggregation.Aggre
 // collectAndExport gather all metric data related to the periodicReader r from
 // the SDK and exports it with r's exporter.
 func (r *periodicReader) collectAndExport(ctx context.Context) error {
+	start := time.Now()
 	m, err := r.Collect(ctx)
+	if r.duration != nil {
+		elapsed := float64(time.Since(start)) / float64(time.Millisecond)
+		r.duration.Record(ctx, elapsed)
+	}
 	if err == nil {
 		err = r.export(ctx, m)
 	}
@@ -196,8 +279,9 @@ func (r *periodicReader) collectAndExport(ctx context.Context) error {
 }
 
 // Collect gathers and returns all metric data related to the Reader from
-// the SDK. The returned metric data is not exported to the configured
-// exporter, it is left to the caller to handle that if desired.
+// the SDK and all external Producers. The returned metric data is not
+// exported to the configured exporter, it is left to the caller to handle
+// that if desired.
 //
 // An error is returned if this is called after Shutdown.
 func (r *periodicReader) Collect(ctx context.Context) (metricdata.ResourceMetrics, error) {
@@ -219,14 +303,34 @@ func (r *periodicReader) collect(ctx context.Context, p interface{}) (metricdata
 		err := fmt.Errorf("periodic reader: invalid producer: %T", p)
 		return metricdata.ResourceMetrics{}, err
 	}
-	return ph.produce(ctx)
+
+	rm, err := ph.produce(ctx)
+	if err != nil {
+		return rm, err
+	}
+	produceExternal(ctx, r.producers, &rm)
+	return rm, nil
 }
 
-// export exports metric data m using r's exporter.
+// export exports metric data m using r's exporter. If m contains more data
+// points than r allows in a single export, it is exported in batches. All
+// batches are attempted and any errors exporting them are combined.
 func (r *periodicReader) export(ctx context.Context, m metricdata.ResourceMetrics) error {
 	c, cancel := context.WithTimeout(ctx, r.timeout)
 	defer cancel()
-	return r.exporter.Export(c, m)
+
+	batches := splitResourceMetrics(m, r.maxBatchSize)
+	if len(batches) == 1 {
+		return r.exporter.Export(c, batches[0])
+	}
+	exports := make([]func(context.Context) error, len(batches))
+	for i := range batches {
+		b := batches[i]
+		exports[i] = func(ctx context.Context) error {
+			return r.exporter.Export(ctx, b)
+		}
+	}
+	return unify(exports)(c)
 }
 
 // ForceFlush flushes pending telemetry.
@@ -16,10 +16,13 @@ package metric // import "go.opentelemetry.io/otel/sdk/metric"
 
 import (
 	"context"
+	"log"
 	"testing"
 	"time"
 
+	"github.com/go-logr/logr/testr"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
 	"go.opentelemetry.io/otel"
@@ -54,6 +57,45 @@ func TestWithInterval(t *testing.T) {
 	assert.Equal(t, defaultInterval, test(time.Duration(-1)), "invalid interval should use default")
 }
 
+func TestWithMaxExportBatchSize(t *testing.T) {
+	assert.Zero(t, newPeriodicReaderConfig(nil).maxExportBatchSize)
+	opts := []PeriodicReaderOption{WithMaxExportBatchSize(10)}
+	assert.Equal(t, 10, newPeriodicReaderConfig(opts).maxExportBatchSize)
+}
+
+func TestPeriodicReaderConfigEnv(t *testing.T) {
+	t.Setenv(envInterval, "5000")
+	t.Setenv(envTimeout, "2000")
+
+	c := newPeriodicReaderConfig(nil)
+	assert.Equal(t, 5*time.Second, c.interval)
+	assert.Equal(t, 2*time.Second, c.timeout)
+
+	c = newPeriodicReaderConfig([]PeriodicReaderOption{
+		WithInterval(testDur),
+		WithTimeout(testDur),
+	})
+	assert.Equal(t, testDur, c.interval, "option should take precedence")
+	assert.Equal(t, testDur, c.timeout, "option should take precedence")
+
+	c = newPeriodicReaderConfig([]PeriodicReaderOption{WithInterval(0)})
+	assert.Equal(t, 5*time.Second, c.interval, "invalid option should not override environment")
+}
+
+func TestPeriodicReaderConfigEnvInvalid(t *testing.T) {
+	// Invalid values are logged.
+	otel.SetLogger(testr.New(t))
+
+	for _, v := range []string{"", "invalid", "0", "-1", "1.5"} {
+		t.Setenv(envInterval, v)
+		t.Setenv(envTimeout, v)
+
+		c := newPeriodicReaderConfig(nil)
+		assert.Equalf(t, defaultInterval, c.interval, "value %q", v)
+		assert.Equalf(t, defaultTimeout, c.timeout, "value %q", v)
+	}
+}
+
 type fnExporter struct {
 	temporalityFunc TemporalitySelector
 	aggregationFunc AggregationSelector
@@ -135,8 +177,12 @@ func (ts *periodicReaderTestSuite) TestShutdownPropagated() {
 func TestPeriodicReader(t *testing.T) {
 	suite.Run(t, &periodicReaderTestSuite{
 		readerTestSuite: &readerTestSuite{
-			Factory: func() Reader {
-				return NewPeriodicReader(new(fnExporter))
+			Factory: func(opts ...ReaderOption) Reader {
+				var popts []PeriodicReaderOption
+				for _, o := range opts {
+					popts = append(popts, o)
+				}
+				return NewPeriodicReader(new(fnExporter), popts...)
 			},
 		},
 	})
@@ -156,6 +202,18 @@ func (eh chErrorHandler) Handle(err error) {
 	eh.Err <- err
 }
 
+// setErrorHandler sets h as the global error handler until the test ends.
+// The handler returned by otel.GetErrorHandler delegates to the one set, it
+// cannot be restored. Errors are logged once the test ends instead.
+func setErrorHandler(t *testing.T, h otel.ErrorHandler) {
+	otel.SetErrorHandler(h)
+	t.Cleanup(func() {
+		otel.SetErrorHandler(otel.ErrorHandlerFunc(func(err error) {
+			log.Print(err)
+		}))
+	})
+}
+
 func triggerTicker(t *testing.T) chan time.Time {
 	t.Helper()
 
@@ -179,11 +237,8 @@ func TestPeriodicReaderRun(t *testing.T) {
 
 	// Register an error handler to validate export errors are passed to
 	// otel.Handle.
-	defer func(orig otel.ErrorHandler) {
-		otel.SetErrorHandler(orig)
-	}(otel.GetErrorHandler())
 	eh := newChErrorHandler()
-	otel.SetErrorHandler(eh)
+	setErrorHandler(t, eh)
 
 	exp := &fnExporter{
 		exportFunc: func(_ context.Context, m metricdata.ResourceMetrics) error {
@@ -202,6 +257,98 @@ func TestPeriodicReaderRun(t *testing.T) {
 	_ = r.Shutdown(context.Background())
 }
 
+func TestPeriodicReaderCollectionDuration(t *testing.T) {
+	trigger := triggerTicker(t)
+
+	selfReader := NewManualReader()
+	selfMP := NewMeterProvider(WithReader(selfReader))
+
+	exported := make(chan struct{}, 1)
+	exp := &fnExporter{
+		exportFunc: func(context.Context, metricdata.ResourceMetrics) error {
+			select {
+			case exported <- struct{}{}:
+			default:
+			}
+			return nil
+		},
+	}
+	r := NewPeriodicReader(exp, WithMeterProvider(selfMP))
+	r.register(testProducer{})
+	trigger <- time.Now()
+	<-exported
+
+	ctx := context.Background()
+	rm, err := selfReader.Collect(ctx)
+	require.NoError(t, err)
+	require.Len(t, rm.ScopeMetrics, 1)
+	sm := rm.ScopeMetrics[0]
+	assert.Equal(t, "go.opentelemetry.io/otel/sdk/metric", sm.Scope.Name)
+	require.Len(t, sm.Metrics, 1)
+	assert.Equal(t, "otel.sdk.metric_reader.collection.duration", sm.Metrics[0].Name)
+	h, ok := sm.Metrics[0].Data.(metricdata.Histogram)
+	require.True(t, ok, "collection duration is not a histogram")
+	require.Len(t, h.DataPoints, 1)
+	assert.Equal(t, uint64(1), h.DataPoints[0].Count)
+
+	// Collect outside of the periodic export is not measured.
+	_, err = r.Collect(ctx)
+	require.NoError(t, err)
+	rm, err = selfReader.Collect(ctx)
+	require.NoError(t, err)
+	h = rm.ScopeMetrics[0].Metrics[0].Data.(metricdata.Histogram)
+	assert.Equal(t, uint64(1), h.DataPoints[0].Count)
+
+	assert.NoError(t, r.Shutdown(ctx))
+}
+
+func TestPeriodicReaderExportBatches(t *testing.T) {
+	var got []metricdata.ResourceMetrics
+	exp := &fnExporter{
+		exportFunc: func(_ context.Context, m metricdata.ResourceMetrics) error {
+			got = append(got, m)
+			return assert.AnError
+		},
+	}
+
+	rm := metricdata.ResourceMetrics{
+		Resource: batchRes,
+		ScopeMetrics: []metricdata.ScopeMetrics{{
+			Scope:   scopeA,
+			Metrics: []metricdata.Metrics{sumMetrics("sum", dPtsAlice, dPtsBob)},
+		}},
+	}
+	r := NewPeriodicReader(exp, WithMaxExportBatchSize(1))
+	r.register(testProducer{
+		produceFunc: func(context.Context) (metricdata.ResourceMetrics, error) {
+			return rm, nil
+		},
+	})
+	// All batches are exported even if some fail.
+	assert.Error(t, r.ForceFlush(context.Background()))
+
+	want := []metricdata.ResourceMetrics{
+		{
+			Resource: batchRes,
+			ScopeMetrics: []metricdata.ScopeMetrics{{
+				Scope:   scopeA,
+				Metrics: []metricdata.Metrics{sumMetrics("sum", dPtsAlice)},
+			}},
+		},
+		{
+			Resource: batchRes,
+			ScopeMetrics: []metricdata.ScopeMetrics{{
+				Scope:   scopeA,
+				Metrics: []metricdata.Metrics{sumMetrics("sum", dPtsBob)},
+			}},
+		},
+	}
+	assert.Equal(t, want, got)
+
+	// Ensure Reader is allowed clean up attempt.
+	_ = r.Shutdown(context.Background())
+}
+
 func TestPeriodicReaderFlushesPending(t *testing.T) {
 	// Override the ticker so tests are not flaky and rely on timing.
 	trigger := triggerTicker(t)
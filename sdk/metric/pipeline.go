@@ -15,17 +15,23 @@
 package metric // import "go.opentelemetry.io/otel/sdk/metric"
 
 import (
+	"container/list"
 	"context"
 	"errors"
 	"fmt"
+	"runtime"
 	"strings"
 	"sync"
+	"sync/atomic"
+	"time"
 
-	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/internal/global"
+	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/metric/unit"
 	"go.opentelemetry.io/otel/sdk/instrumentation"
 	"go.opentelemetry.io/otel/sdk/metric/aggregation"
+	"go.opentelemetry.io/otel/sdk/metric/exemplar"
 	"go.opentelemetry.io/otel/sdk/metric/internal"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 	"go.opentelemetry.io/otel/sdk/metric/view"
@@ -37,6 +43,9 @@ var (
 	errIncompatibleAggregation = errors.New("incompatible aggregation")
 	errUnknownAggregation      = errors.New("unrecognized aggregation")
 	errUnknownTemporality      = errors.New("unrecognized temporality")
+	errCallbackTimeout         = errors.New("callback timed out")
+	errCallbackPanic           = errors.New("callback panicked")
+	errCallbackRunning         = errors.New("callback still running")
 )
 
 type aggregator interface {
@@ -74,10 +83,18 @@ type pipeline struct {
 
 	reader Reader
 	views  []view.View
+	// exemplarFilter determines the measurements offered to exemplar
+	// reservoirs. If nil, no exemplars are sampled.
+	exemplarFilter exemplar.Filter
+	// cardinalityLimit is the default maximum number of attribute sets
+	// aggregated for an instrument. If not positive, there is no limit.
+	cardinalityLimit int
+	// callbackConf determines how callbacks are run during a collection.
+	callbackConf callbackConfig
 
 	sync.Mutex
 	aggregations map[instrumentation.Scope][]instrumentSync
-	callbacks    []func(context.Context)
+	callbacks    list.List
 }
 
 // addSync adds the instrumentSync to pipeline p with scope. This method is not
@@ -95,19 +112,26 @@ func (p *pipeline) addSync(scope instrumentation.Scope, iSync instrumentSync) {
 	p.aggregations[scope] = append(p.aggregations[scope], iSync)
 }
 
-// addCallback registers a callback to be run when `produce()` is called.
-func (p *pipeline) addCallback(callback func(context.Context)) {
+// addCallback registers a callback to be run when `produce()` is called. The
+// returned function removes the callback from p.
+func (p *pipeline) addCallback(f func(context.Context)) (unregister func()) {
 	p.Lock()
 	defer p.Unlock()
-	p.callbacks = append(p.callbacks, callback)
+	e := p.callbacks.PushBack(&callback{f: f})
+	return func() {
+		p.Lock()
+		_ = p.callbacks.Remove(e)
+		p.Unlock()
+	}
 }
 
 // callbackKey is a context key type used to identify context that came from the SDK.
 type callbackKey int
 
 // produceKey is the context key to tell if a Observe is called within a callback.
-// Its value of zero is arbitrary. If this package defined other context keys,
-// they would have different integer values.
+// The value stored with it is the *callbackRun of the callback. Its value of
+// zero is arbitrary. If this package defined other context keys, they would
+// have different integer values.
 const produceKey callbackKey = 0
 
 // produce returns aggregated metrics from a single collection.
@@ -117,15 +141,14 @@ func (p *pipeline) produce(ctx context.Context) (metricdata.ResourceMetrics, err
 	p.Lock()
 	defer p.Unlock()
 
-	ctx = context.WithValue(ctx, produceKey, struct{}{})
-
-	for _, callback := range p.callbacks {
-		// TODO make the callbacks parallel. ( #3034 )
-		callback(ctx)
-		if err := ctx.Err(); err != nil {
-			// This means the context expired before we finished running callbacks.
-			return metricdata.ResourceMetrics{}, err
-		}
+	callbacks := make([]*callback, 0, p.callbacks.Len())
+	for e := p.callbacks.Front(); e != nil; e = e.Next() {
+		callbacks = append(callbacks, e.Value.(*callback))
+	}
+	p.callbackConf.runAll(ctx, callbacks)
+	if err := ctx.Err(); err != nil {
+		// This means the context expired before we finished running callbacks.
+		return metricdata.ResourceMetrics{}, err
 	}
 
 	sm := make([]metricdata.ScopeMetrics, 0, len(p.aggregations))
@@ -156,6 +179,169 @@ func (p *pipeline) produce(ctx context.Context) (metricdata.ResourceMetrics, err
 	}, nil
 }
 
+// callbackConfig determines how the callbacks registered with a pipeline are
+// run during a collection.
+type callbackConfig struct {
+	// timeout is the maximum duration a single callback is allowed to run.
+	// If not positive, callbacks run until the collection context is done.
+	timeout time.Duration
+	// concurrency is the maximum number of callbacks run concurrently. If not
+	// greater than one, callbacks are run sequentially.
+	concurrency int
+}
+
+// runAll runs all callbacks with ctx and returns once they are done. If ctx is
+// done before all callbacks are run, the remaining callbacks are not run.
+func (c callbackConfig) runAll(ctx context.Context, callbacks []*callback) {
+	workers := c.concurrency
+	if workers > len(callbacks) {
+		workers = len(callbacks)
+	}
+	if workers <= 1 {
+		for _, cb := range callbacks {
+			if ctx.Err() != nil {
+				return
+			}
+			c.run(ctx, cb)
+		}
+		return
+	}
+
+	queue := make(chan *callback)
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer wg.Done()
+			for cb := range queue {
+				c.run(ctx, cb)
+			}
+		}()
+	}
+	defer func() {
+		close(queue)
+		wg.Wait()
+	}()
+
+	for _, cb := range callbacks {
+		select {
+		case queue <- cb:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// callback is a callback registered with a pipeline.
+type callback struct {
+	f func(context.Context)
+	// running is 1 while a run of f has not returned, otherwise 0. It is
+	// updated atomically.
+	running uint32
+}
+
+// callbackRun guards the observations made by a single run of a callback.
+// Observations are made while holding the read lock, ending the run waits
+// for them to complete and drops any made afterwards.
+type callbackRun struct {
+	sync.RWMutex
+	ended bool
+}
+
+// begin returns if the run has not ended. If true is returned, the run cannot
+// end until finish is called.
+func (r *callbackRun) begin() bool {
+	r.RLock()
+	if r.ended {
+		r.RUnlock()
+		return false
+	}
+	return true
+}
+
+// finish ends an observation started with begin.
+func (r *callbackRun) finish() {
+	r.RUnlock()
+}
+
+// end ends the run once all observations in progress have completed.
+func (r *callbackRun) end() {
+	r.Lock()
+	r.ended = true
+	r.Unlock()
+}
+
+// observe calls f if ctx belongs to a callback run that has not ended. The
+// run cannot end while f is called.
+func observe(ctx context.Context, f func()) {
+	r, ok := ctx.Value(produceKey).(*callbackRun)
+	if !ok || !r.begin() {
+		// Not called from a callback, or the callback was abandoned and its
+		// observations are no longer collected.
+		return
+	}
+	defer r.finish()
+	f()
+}
+
+// run runs the callback cb with ctx.
+//
+// If cb panics, the panic is recovered and reported to the OTel error handler.
+// If c has a timeout and cb does not return within it, cb is abandoned and
+// this is reported to the OTel error handler. Observations cb makes after it
+// returns or is abandoned are dropped. An abandoned cb is not run again
+// until it returns, each collection skipping it reports this to the OTel
+// error handler. This bounds the goroutines of stuck callbacks to one per
+// callback.
+func (c callbackConfig) run(ctx context.Context, cb *callback) {
+	if !atomic.CompareAndSwapUint32(&cb.running, 0, 1) {
+		otel.Handle(fmt.Errorf("%w: skipped, an abandoned run has not returned", errCallbackRunning))
+		return
+	}
+
+	r := &callbackRun{}
+	// Observations made once the run ends, even by an abandoned cb, are
+	// dropped so they do not leak into the next aggregation cycle.
+	defer r.end()
+
+	if c.timeout <= 0 {
+		defer atomic.StoreUint32(&cb.running, 0)
+		callSafe(context.WithValue(ctx, produceKey, r), cb.f)
+		return
+	}
+
+	cbCtx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		defer atomic.StoreUint32(&cb.running, 0)
+		callSafe(context.WithValue(cbCtx, produceKey, r), cb.f)
+	}()
+
+	select {
+	case <-done:
+		return
+	case <-cbCtx.Done():
+	}
+	// Only report the callback if it was the one at fault, not the
+	// collection as a whole.
+	if ctx.Err() == nil {
+		otel.Handle(fmt.Errorf("%w: exceeded %s", errCallbackTimeout, c.timeout))
+	}
+}
+
+// callSafe calls cb with ctx, recovering from and reporting any panic.
+func callSafe(ctx context.Context, cb func(context.Context)) {
+	defer func() {
+		if r := recover(); r != nil {
+			otel.Handle(fmt.Errorf("%w: %v", errCallbackPanic, r))
+		}
+	}()
+	cb(ctx)
+}
+
 // inserter facilitates inserting of new instruments from a single scope into a
 // pipeline.
 type inserter[N int64 | float64] struct {
@@ -207,7 +393,7 @@ func (i *inserter[N]) Instrument(key instProviderKey) ([]internal.Aggregator[N],
 		}
 		matched = true
 
-		agg, err := i.cachedAggregator(inst, key.Unit, v.AttributeFilter())
+		agg, err := i.cachedAggregator(inst, key.Unit, key.Boundaries, v)
 		if err != nil {
 			errs.append(err)
 		}
@@ -227,7 +413,7 @@ func (i *inserter[N]) Instrument(key instProviderKey) ([]internal.Aggregator[N],
 	}
 
 	// Apply implicit default view if no explicit matched.
-	agg, err := i.cachedAggregator(inst, key.Unit, nil)
+	agg, err := i.cachedAggregator(inst, key.Unit, key.Boundaries, view.View{})
 	if err != nil {
 		errs.append(err)
 	}
@@ -244,18 +430,30 @@ func (i *inserter[N]) Instrument(key instProviderKey) ([]internal.Aggregator[N],
 // computed Aggregator will be cached and returned.
 //
 // If the instrument configuration conflicts with an instrument that has
-// already been created (e.g. description, unit, data type) a warning will be
-// logged at the "Info" level with the global OTel logger. A valid new
-// Aggregator for the instrument configuration will still be returned without
-// an error.
+// already been created (e.g. description, unit, data type, advised bucket
+// boundaries) a warning will be logged at the "Info" level with the global
+// OTel logger. A valid new Aggregator for the instrument configuration will
+// still be returned without an error.
+//
+// The attribute filter, exemplar reservoir, and cardinality limit of v are
+// applied to the returned Aggregator. If the pipeline samples exemplars and
+// the instrument is synchronous, the returned Aggregator will sample
+// exemplars using the Reservoirs of v, or the default Reservoir for the
+// aggregation if v does not define one.
+//
+// If the instrument uses the default aggregation of the Reader and that is an
+// explicit bucket histogram, the advised bounds are used as its boundaries.
+// A view setting the aggregation of the instrument takes precedence.
 //
 // If the instrument defines an unknown or incompatible aggregation, an error
 // is returned.
-func (i *inserter[N]) cachedAggregator(inst view.Instrument, u unit.Unit, filter func(attribute.Set) attribute.Set) (internal.Aggregator[N], error) {
+func (i *inserter[N]) cachedAggregator(inst view.Instrument, u unit.Unit, bounds []float64, v view.View) (internal.Aggregator[N], error) {
+	var advised []float64
 	switch inst.Aggregation.(type) {
 	case nil, aggregation.Default:
 		// Undefined, nil, means to use the default from the reader.
 		inst.Aggregation = i.pipeline.reader.aggregation(inst.Kind)
+		inst.Aggregation, advised = adviseBoundaries(inst, bounds)
 	}
 
 	if err := isAggregatorCompatible(inst.Kind, inst.Aggregation); err != nil {
@@ -265,7 +463,7 @@ func (i *inserter[N]) cachedAggregator(inst view.Instrument, u unit.Unit, filter
 		)
 	}
 
-	id := i.instrumentID(inst, u)
+	id := i.instrumentID(inst, u, advised)
 	// If there is a conflict, the specification says the view should
 	// still be applied and a warning should be logged.
 	i.logConflict(id)
@@ -277,9 +475,19 @@ func (i *inserter[N]) cachedAggregator(inst view.Instrument, u unit.Unit, filter
 		if agg == nil { // Drop aggregator.
 			return nil, nil
 		}
-		if filter != nil {
-			agg = internal.NewFilter(agg, filter)
+		if i.pipeline.exemplarFilter != nil && isSync(inst.Kind) {
+			res := v.ExemplarReservoir()
+			if res == nil {
+				res = defaultReservoir(inst.Aggregation)
+			}
+			agg = internal.NewExemplarSampler(agg, i.pipeline.exemplarFilter, newReservoirFunc[N](res))
+		}
+		limit := v.CardinalityLimit()
+		if limit <= 0 {
+			limit = i.pipeline.cardinalityLimit
 		}
+		agg = internal.NewLimiter(agg, inst.Name, limit)
+		agg = internal.NewFilter(agg, v.AttributeFilter())
 
 		i.pipeline.addSync(inst.Scope, instrumentSync{
 			name:        inst.Name,
@@ -291,6 +499,23 @@ func (i *inserter[N]) cachedAggregator(inst view.Instrument, u unit.Unit, filter
 	})
 }
 
+// adviseBoundaries returns the aggregation of inst with its boundaries
+// replaced by bounds if inst is a histogram instrument aggregated with an
+// explicit bucket histogram, and the boundaries used. If bounds is nil or
+// invalid the aggregation of inst is returned unchanged along with nil.
+func adviseBoundaries(inst view.Instrument, bounds []float64) (aggregation.Aggregation, []float64) {
+	h, ok := inst.Aggregation.(aggregation.ExplicitBucketHistogram)
+	if !ok || bounds == nil || inst.Kind != view.SyncHistogram {
+		return inst.Aggregation, nil
+	}
+	h.Boundaries = bounds
+	if err := h.Err(); err != nil {
+		global.Error(err, "not using advised bucket boundaries", "instrument", inst.Name)
+		return inst.Aggregation, nil
+	}
+	return h, bounds
+}
+
 // logConflict validates if an instrument with the same name as id has already
 // been created. If that instrument conflicts with id, a warning is logged.
 func (i *inserter[N]) logConflict(id instrumentID) {
@@ -308,10 +533,15 @@ func (i *inserter[N]) logConflict(id instrumentID) {
 		"aggregations", fmt.Sprintf("%s, %s", existing.Aggregation, id.Aggregation),
 		"monotonics", fmt.Sprintf("%t, %t", existing.Monotonic, id.Monotonic),
 		"temporalities", fmt.Sprintf("%s, %s", existing.Temporality.String(), id.Temporality.String()),
+		"boundaries", fmt.Sprintf("%s, %s", existing.Boundaries, id.Boundaries),
 	)
 }
 
-func (i *inserter[N]) instrumentID(vi view.Instrument, u unit.Unit) instrumentID {
+// instrumentID returns the identity of the instrument vi with unit u. The
+// advised bucket boundaries used by its aggregation, if any, are part of the
+// identity so instruments advising different boundaries do not share an
+// Aggregator.
+func (i *inserter[N]) instrumentID(vi view.Instrument, u unit.Unit, advised []float64) instrumentID {
 	var zero N
 	id := instrumentID{
 		Name:        vi.Name,
@@ -321,6 +551,9 @@ func (i *inserter[N]) instrumentID(vi view.Instrument, u unit.Unit) instrumentID
 		Temporality: i.pipeline.reader.temporality(vi.Kind),
 		Number:      fmt.Sprintf("%T", zero),
 	}
+	if advised != nil {
+		id.Boundaries = fmt.Sprint(advised)
+	}
 
 	switch vi.Kind {
 	case view.AsyncCounter, view.SyncCounter, view.SyncHistogram:
@@ -372,10 +605,54 @@ func (i *inserter[N]) aggregator(agg aggregation.Aggregation, kind view.Instrume
 		default:
 			return nil, fmt.Errorf("%w: %s(%d)", errUnknownTemporality, temporality.String(), temporality)
 		}
+	case aggregation.Base2ExponentialHistogram:
+		switch temporality {
+		case metricdata.CumulativeTemporality:
+			return internal.NewCumulativeExponentialHistogram[N](a), nil
+		case metricdata.DeltaTemporality:
+			return internal.NewDeltaExponentialHistogram[N](a), nil
+		default:
+			return nil, fmt.Errorf("%w: %s(%d)", errUnknownTemporality, temporality.String(), temporality)
+		}
 	}
 	return nil, errUnknownAggregation
 }
 
+// isSync returns if kind is a synchronous instrument kind.
+func isSync(kind view.InstrumentKind) bool {
+	switch kind {
+	case view.SyncCounter, view.SyncUpDownCounter, view.SyncHistogram, view.SyncGauge:
+		return true
+	}
+	return false
+}
+
+// defaultReservoir returns the default exemplar ReservoirProvider for agg.
+// Explicit bucket histograms sample an exemplar for each of their buckets,
+// all other aggregations sample a fixed number of exemplars.
+func defaultReservoir(agg aggregation.Aggregation) exemplar.ReservoirProvider {
+	if a, ok := agg.(aggregation.ExplicitBucketHistogram); ok {
+		return exemplar.Histogram(a.Boundaries)
+	}
+	return exemplar.FixedSize(runtime.NumCPU())
+}
+
+// newReservoirFunc returns a function that creates new Reservoirs for N using
+// p.
+func newReservoirFunc[N int64 | float64](p exemplar.ReservoirProvider) func() exemplar.Reservoir[N] {
+	var zero N
+	switch any(zero).(type) {
+	case int64:
+		return func() exemplar.Reservoir[N] {
+			return any(p.Int64()).(exemplar.Reservoir[N])
+		}
+	default:
+		return func() exemplar.Reservoir[N] {
+			return any(p.Float64()).(exemplar.Reservoir[N])
+		}
+	}
+}
+
 // isAggregatorCompatible checks if the aggregation can be used by the instrument.
 // Current compatibility:
 //
@@ -386,10 +663,11 @@ func (i *inserter[N]) aggregator(agg aggregation.Aggregation, kind view.Instrume
 // | Sync Histogram       | X    |           | X   | X         | X                     |
 // | Async Counter        | X    |           | X   |           |                       |
 // | Async UpDown Counter | X    |           | X   |           |                       |
-// | Async Gauge          | X    | X         |     |           |                       |.
+// | Async Gauge          | X    | X         |     |           |                       |
+// | Sync Gauge           | X    | X         |     |           |                       |.
 func isAggregatorCompatible(kind view.InstrumentKind, agg aggregation.Aggregation) error {
 	switch agg.(type) {
-	case aggregation.ExplicitBucketHistogram:
+	case aggregation.ExplicitBucketHistogram, aggregation.Base2ExponentialHistogram:
 		if kind == view.SyncCounter || kind == view.SyncHistogram {
 			return nil
 		}
@@ -406,7 +684,7 @@ func isAggregatorCompatible(kind view.InstrumentKind, agg aggregation.Aggregatio
 			return errIncompatibleAggregation
 		}
 	case aggregation.LastValue:
-		if kind == view.AsyncGauge {
+		if kind == view.AsyncGauge || kind == view.SyncGauge {
 			return nil
 		}
 		// TODO: review need for aggregation check after
@@ -424,13 +702,25 @@ func isAggregatorCompatible(kind view.InstrumentKind, agg aggregation.Aggregatio
 // measurement.
 type pipelines []*pipeline
 
-func newPipelines(res *resource.Resource, readers []Reader, views []view.View) pipelines {
+// newPipelines returns a pipeline for each of readers. All pipelines use
+// views, and the pipeline of readers[i] also uses readerViews[i] if it
+// exists.
+func newPipelines(res *resource.Resource, readers []Reader, views []view.View, readerViews [][]view.View, filter exemplar.Filter, limit int, cbConf callbackConfig) pipelines {
 	pipes := make([]*pipeline, 0, len(readers))
-	for _, r := range readers {
+	for i, r := range readers {
+		v := views
+		if i < len(readerViews) && len(readerViews[i]) > 0 {
+			v = make([]view.View, 0, len(views)+len(readerViews[i]))
+			v = append(v, views...)
+			v = append(v, readerViews[i]...)
+		}
 		p := &pipeline{
-			resource: res,
-			reader:   r,
-			views:    views,
+			resource:         res,
+			reader:           r,
+			views:            v,
+			exemplarFilter:   filter,
+			cardinalityLimit: limit,
+			callbackConf:     cbConf,
 		}
 		r.register(p)
 		pipes = append(pipes, p)
@@ -438,10 +728,25 @@ func newPipelines(res *resource.Resource, readers []Reader, views []view.View) p
 	return pipes
 }
 
-func (p pipelines) registerCallback(fn func(context.Context)) {
-	for _, pipe := range p {
-		pipe.addCallback(fn)
+func (p pipelines) registerCallback(fn func(context.Context)) metric.Registration {
+	unregs := make([]func(), len(p))
+	for i, pipe := range p {
+		unregs[i] = pipe.addCallback(fn)
+	}
+	return unregisterFuncs(unregs)
+}
+
+// unregisterFuncs is a metric.Registration that calls each of its functions
+// to unregister a callback from all pipelines.
+type unregisterFuncs []func()
+
+// Unregister removes the callback from all the pipelines it was added to. It
+// is safe to call multiple times.
+func (u unregisterFuncs) Unregister() error {
+	for _, f := range u {
+		f()
 	}
+	return nil
 }
 
 // resolver facilitates resolving Aggregators an instrument needs to aggregate
@@ -451,10 +756,21 @@ type resolver[N int64 | float64] struct {
 	inserters []*inserter[N]
 }
 
-func newResolver[N int64 | float64](s instrumentation.Scope, p pipelines, c instrumentCache[N]) resolver[N] {
+// newResolver returns a resolver that inserts instruments into each of p.
+//
+// Each pipeline caches its own aggregators, and uses the view cache of vcs
+// with the same index to detect instrument conflicts. This means conflicts
+// are only detected between the instruments of a single pipeline, which
+// have the views of a single Reader applied. If vcs does not contain a view
+// cache for a pipeline, a new one is used.
+func newResolver[N int64 | float64](s instrumentation.Scope, p pipelines, vcs []*cache[string, instrumentID]) resolver[N] {
 	in := make([]*inserter[N], len(p))
 	for i := range in {
-		in[i] = newInserter(s, p[i], c)
+		var vc *cache[string, instrumentID]
+		if i < len(vcs) {
+			vc = vcs[i]
+		}
+		in[i] = newInserter(s, p[i], newInstrumentCache[N](nil, vc))
 	}
 	return resolver[N]{in}
 }
@@ -56,6 +56,10 @@ func testCreateAggregators[N int64 | float64](t *testing.T) {
 		view.MatchInstrumentName("foo"),
 		view.WithSetAggregation(aggregation.Default{}),
 	)
+	expoHistView, _ := view.New(
+		view.MatchInstrumentName("foo"),
+		view.WithSetAggregation(aggregation.Base2ExponentialHistogram{MaxSize: 160, MaxScale: 20}),
+	)
 	invalidAggView, _ := view.New(
 		view.MatchInstrumentName("foo"),
 		view.WithSetAggregation(invalidAggregation{}),
@@ -190,6 +194,29 @@ func testCreateAggregators[N int64 | float64](t *testing.T) {
 			wantKind: internal.NewCumulativeHistogram[N](aggregation.ExplicitBucketHistogram{}),
 			wantLen:  1,
 		},
+		{
+			name:     "view should set exponential histogram",
+			reader:   NewManualReader(),
+			views:    []view.View{expoHistView},
+			inst:     instruments[view.SyncHistogram],
+			wantKind: internal.NewCumulativeExponentialHistogram[N](aggregation.Base2ExponentialHistogram{}),
+			wantLen:  1,
+		},
+		{
+			name:     "view should set delta exponential histogram",
+			reader:   NewManualReader(WithTemporalitySelector(deltaTemporalitySelector)),
+			views:    []view.View{expoHistView},
+			inst:     instruments[view.SyncCounter],
+			wantKind: internal.NewDeltaExponentialHistogram[N](aggregation.Base2ExponentialHistogram{}),
+			wantLen:  1,
+		},
+		{
+			name:    "view should error with incompatible exponential histogram",
+			reader:  NewManualReader(),
+			views:   []view.View{expoHistView},
+			inst:    instruments[view.AsyncGauge],
+			wantErr: errCreatingAggregators,
+		},
 		{
 			name:     "multiple views should create multiple aggregators",
 			reader:   NewManualReader(),
@@ -307,7 +334,7 @@ func TestPipelineRegistryCreateAggregators(t *testing.T) {
 
 	for _, tt := range testCases {
 		t.Run(tt.name, func(t *testing.T) {
-			p := newPipelines(resource.Empty(), tt.readers, tt.views)
+			p := newPipelines(resource.Empty(), tt.readers, tt.views, nil, nil, 0, callbackConfig{})
 			testPipelineRegistryResolveIntAggregators(t, p, tt.wantCount)
 			testPipelineRegistryResolveFloatAggregators(t, p, tt.wantCount)
 		})
@@ -317,9 +344,8 @@ func TestPipelineRegistryCreateAggregators(t *testing.T) {
 func testPipelineRegistryResolveIntAggregators(t *testing.T, p pipelines, wantCount int) {
 	inst := instProviderKey{Name: "foo", Kind: view.SyncCounter}
 
-	c := newInstrumentCache[int64](nil, nil)
 	s := instrumentation.Scope{Name: "testPipelineRegistryResolveIntAggregators"}
-	r := newResolver(s, p, c)
+	r := newResolver[int64](s, p, nil)
 	aggs, err := r.Aggregators(inst)
 	assert.NoError(t, err)
 
@@ -329,9 +355,8 @@ func testPipelineRegistryResolveIntAggregators(t *testing.T, p pipelines, wantCo
 func testPipelineRegistryResolveFloatAggregators(t *testing.T, p pipelines, wantCount int) {
 	inst := instProviderKey{Name: "foo", Kind: view.SyncCounter}
 
-	c := newInstrumentCache[float64](nil, nil)
 	s := instrumentation.Scope{Name: "testPipelineRegistryResolveFloatAggregators"}
-	r := newResolver(s, p, c)
+	r := newResolver[float64](s, p, nil)
 	aggs, err := r.Aggregators(inst)
 	assert.NoError(t, err)
 
@@ -344,7 +369,7 @@ func TestPipelineRegistryResource(t *testing.T) {
 	readers := []Reader{NewManualReader()}
 	views := []view.View{{}, v}
 	res := resource.NewSchemaless(attribute.String("key", "val"))
-	pipes := newPipelines(res, readers, views)
+	pipes := newPipelines(res, readers, views, nil, nil, 0, callbackConfig{})
 	for _, p := range pipes {
 		assert.True(t, res.Equal(p.resource), "resource not set")
 	}
@@ -355,17 +380,17 @@ func TestPipelineRegistryCreateAggregatorsIncompatibleInstrument(t *testing.T) {
 
 	readers := []Reader{testRdrHistogram}
 	views := []view.View{{}}
-	p := newPipelines(resource.Empty(), readers, views)
+	p := newPipelines(resource.Empty(), readers, views, nil, nil, 0, callbackConfig{})
 	inst := instProviderKey{Name: "foo", Kind: view.AsyncGauge}
 
 	vc := cache[string, instrumentID]{}
 	s := instrumentation.Scope{Name: "TestPipelineRegistryCreateAggregatorsIncompatibleInstrument"}
-	ri := newResolver(s, p, newInstrumentCache[int64](nil, &vc))
+	ri := newResolver[int64](s, p, []*cache[string, instrumentID]{&vc})
 	intAggs, err := ri.Aggregators(inst)
 	assert.Error(t, err)
 	assert.Len(t, intAggs, 0)
 
-	rf := newResolver(s, p, newInstrumentCache[float64](nil, &vc))
+	rf := newResolver[float64](s, p, []*cache[string, instrumentID]{&vc})
 	floatAggs, err := rf.Aggregators(inst)
 	assert.Error(t, err)
 	assert.Len(t, floatAggs, 0)
@@ -401,11 +426,11 @@ func TestResolveAggregatorsDuplicateErrors(t *testing.T) {
 	fooInst := instProviderKey{Name: "foo", Kind: view.SyncCounter}
 	barInst := instProviderKey{Name: "bar", Kind: view.SyncCounter}
 
-	p := newPipelines(resource.Empty(), readers, views)
+	p := newPipelines(resource.Empty(), readers, views, nil, nil, 0, callbackConfig{})
 
 	vc := cache[string, instrumentID]{}
 	s := instrumentation.Scope{Name: "TestPipelineRegistryCreateAggregatorsDuplicateErrors"}
-	ri := newResolver(s, p, newInstrumentCache[int64](nil, &vc))
+	ri := newResolver[int64](s, p, []*cache[string, instrumentID]{&vc})
 	intAggs, err := ri.Aggregators(fooInst)
 	assert.NoError(t, err)
 	assert.Equal(t, 0, l.InfoN(), "no info logging should happen")
@@ -420,7 +445,7 @@ func TestResolveAggregatorsDuplicateErrors(t *testing.T) {
 
 	// Creating a float foo instrument should log a warning because there is an
 	// int foo instrument.
-	rf := newResolver(s, p, newInstrumentCache[float64](nil, &vc))
+	rf := newResolver[float64](s, p, []*cache[string, instrumentID]{&vc})
 	floatAggs, err := rf.Aggregators(fooInst)
 	assert.NoError(t, err)
 	assert.Equal(t, 1, l.InfoN(), "instrument conflict not logged")
@@ -441,6 +466,37 @@ func TestResolveAggregatorsDuplicateErrors(t *testing.T) {
 	assert.Len(t, floatAggs, 2)
 }
 
+func TestResolveAggregatorsAdvisedBoundariesConflict(t *testing.T) {
+	tLog := testr.NewWithOptions(t, testr.Options{Verbosity: 6})
+	l := &logCounter{LogSink: tLog.GetSink()}
+	otel.SetLogger(logr.New(l))
+
+	readers := []Reader{NewManualReader()}
+	p := newPipelines(resource.Empty(), readers, nil, nil, nil, 0, callbackConfig{})
+
+	vc := cache[string, instrumentID]{}
+	s := instrumentation.Scope{Name: "TestResolveAggregatorsAdvisedBoundariesConflict"}
+	r := newResolver[int64](s, p, []*cache[string, instrumentID]{&vc})
+
+	inst := instProviderKey{Name: "foo", Kind: view.SyncHistogram, Boundaries: []float64{1, 2, 3}}
+	aggs, err := r.Aggregators(inst)
+	require.NoError(t, err)
+	require.Len(t, aggs, 1)
+	assert.Equal(t, 0, l.InfoN(), "no info logging should happen")
+
+	same, err := r.Aggregators(instProviderKey{Name: "foo", Kind: view.SyncHistogram, Boundaries: []float64{1, 2, 3}})
+	require.NoError(t, err)
+	require.Len(t, same, 1)
+	assert.Same(t, aggs[0], same[0], "same advised boundaries not deduplicated")
+	assert.Equal(t, 0, l.InfoN(), "no info logging should happen")
+
+	other, err := r.Aggregators(instProviderKey{Name: "foo", Kind: view.SyncHistogram, Boundaries: []float64{4, 5}})
+	require.NoError(t, err)
+	require.Len(t, other, 1)
+	assert.NotSame(t, aggs[0], other[0], "aggregator with different advised boundaries reused")
+	assert.Equal(t, 1, l.InfoN(), "advised boundaries conflict not logged")
+}
+
 func TestIsAggregatorCompatible(t *testing.T) {
 	var undefinedInstrument view.InstrumentKind
 
@@ -471,6 +527,11 @@ func TestIsAggregatorCompatible(t *testing.T) {
 			kind: view.SyncCounter,
 			agg:  aggregation.ExplicitBucketHistogram{},
 		},
+		{
+			name: "SyncCounter and Base2ExponentialHistogram",
+			kind: view.SyncCounter,
+			agg:  aggregation.Base2ExponentialHistogram{},
+		},
 		{
 			name: "SyncUpDownCounter and Drop",
 			kind: view.SyncUpDownCounter,
@@ -493,6 +554,12 @@ func TestIsAggregatorCompatible(t *testing.T) {
 			agg:  aggregation.ExplicitBucketHistogram{},
 			want: errIncompatibleAggregation,
 		},
+		{
+			name: "SyncUpDownCounter and Base2ExponentialHistogram",
+			kind: view.SyncUpDownCounter,
+			agg:  aggregation.Base2ExponentialHistogram{},
+			want: errIncompatibleAggregation,
+		},
 		{
 			name: "SyncHistogram and Drop",
 			kind: view.SyncHistogram,
@@ -514,6 +581,11 @@ func TestIsAggregatorCompatible(t *testing.T) {
 			kind: view.SyncHistogram,
 			agg:  aggregation.ExplicitBucketHistogram{},
 		},
+		{
+			name: "SyncHistogram and Base2ExponentialHistogram",
+			kind: view.SyncHistogram,
+			agg:  aggregation.Base2ExponentialHistogram{},
+		},
 		{
 			name: "AsyncCounter and Drop",
 			kind: view.AsyncCounter,
@@ -536,6 +608,12 @@ func TestIsAggregatorCompatible(t *testing.T) {
 			agg:  aggregation.ExplicitBucketHistogram{},
 			want: errIncompatibleAggregation,
 		},
+		{
+			name: "AsyncCounter and Base2ExponentialHistogram",
+			kind: view.AsyncCounter,
+			agg:  aggregation.Base2ExponentialHistogram{},
+			want: errIncompatibleAggregation,
+		},
 		{
 			name: "AsyncUpDownCounter and Drop",
 			kind: view.AsyncUpDownCounter,
@@ -558,6 +636,12 @@ func TestIsAggregatorCompatible(t *testing.T) {
 			agg:  aggregation.ExplicitBucketHistogram{},
 			want: errIncompatibleAggregation,
 		},
+		{
+			name: "AsyncUpDownCounter and Base2ExponentialHistogram",
+			kind: view.AsyncUpDownCounter,
+			agg:  aggregation.Base2ExponentialHistogram{},
+			want: errIncompatibleAggregation,
+		},
 		{
 			name: "AsyncGauge and Drop",
 			kind: view.AsyncGauge,
@@ -580,6 +664,40 @@ func TestIsAggregatorCompatible(t *testing.T) {
 			agg:  aggregation.ExplicitBucketHistogram{},
 			want: errIncompatibleAggregation,
 		},
+		{
+			name: "AsyncGauge and Base2ExponentialHistogram",
+			kind: view.AsyncGauge,
+			agg:  aggregation.Base2ExponentialHistogram{},
+			want: errIncompatibleAggregation,
+		},
+		{
+			name: "SyncGauge and Drop",
+			kind: view.SyncGauge,
+			agg:  aggregation.Drop{},
+		},
+		{
+			name: "SyncGauge and aggregation.LastValue{}",
+			kind: view.SyncGauge,
+			agg:  aggregation.LastValue{},
+		},
+		{
+			name: "SyncGauge and Sum",
+			kind: view.SyncGauge,
+			agg:  aggregation.Sum{},
+			want: errIncompatibleAggregation,
+		},
+		{
+			name: "SyncGauge and ExplicitBucketHistogram",
+			kind: view.SyncGauge,
+			agg:  aggregation.ExplicitBucketHistogram{},
+			want: errIncompatibleAggregation,
+		},
+		{
+			name: "SyncGauge and Base2ExponentialHistogram",
+			kind: view.SyncGauge,
+			agg:  aggregation.Base2ExponentialHistogram{},
+			want: errIncompatibleAggregation,
+		},
 		{
 			name: "Default aggregation should error",
 			kind: view.SyncCounter,
@@ -613,3 +731,60 @@ func TestIsAggregatorCompatible(t *testing.T) {
 		})
 	}
 }
+
+func TestNewPipelinesReaderViews(t *testing.T) {
+	v0, err := view.New(view.MatchInstrumentName("a"), view.WithRename("b"))
+	require.NoError(t, err)
+	v1, err := view.New(view.MatchInstrumentName("c"), view.WithRename("d"))
+	require.NoError(t, err)
+
+	readers := []Reader{NewManualReader(), NewManualReader()}
+	views := []view.View{v0}
+	pipes := newPipelines(resource.Empty(), readers, views, [][]view.View{nil, {v1}}, nil, 0, callbackConfig{})
+	require.Len(t, pipes, 2)
+	assert.Equal(t, []view.View{v0}, pipes[0].views)
+	assert.Equal(t, []view.View{v0, v1}, pipes[1].views)
+	assert.Equal(t, []view.View{v0}, views, "provider views modified")
+}
+
+func TestResolveAggregatorsPerPipeline(t *testing.T) {
+	readers := []Reader{NewManualReader(), NewManualReader()}
+	p := newPipelines(resource.Empty(), readers, nil, nil, nil, 0, callbackConfig{})
+
+	s := instrumentation.Scope{Name: "TestResolveAggregatorsPerPipeline"}
+	r := newResolver[int64](s, p, nil)
+	aggs, err := r.Aggregators(instProviderKey{Name: "foo", Kind: view.SyncCounter})
+	require.NoError(t, err)
+	require.Len(t, aggs, 2)
+	assert.NotSame(t, aggs[0], aggs[1], "pipelines share an aggregator")
+}
+
+func TestResolveAggregatorsPerPipelineConflicts(t *testing.T) {
+	tLog := testr.NewWithOptions(t, testr.Options{Verbosity: 6})
+	l := &logCounter{LogSink: tLog.GetSink()}
+	otel.SetLogger(logr.New(l))
+
+	histView, err := view.New(
+		view.MatchInstrumentName("foo"),
+		view.WithSetAggregation(aggregation.ExplicitBucketHistogram{}),
+	)
+	require.NoError(t, err)
+	readers := []Reader{NewManualReader(), NewManualReader()}
+	p := newPipelines(resource.Empty(), readers, nil, [][]view.View{{histView}}, nil, 0, callbackConfig{})
+
+	vcs := []*cache[string, instrumentID]{{}, {}}
+	s := instrumentation.Scope{Name: "TestResolveAggregatorsPerPipelineConflicts"}
+	r := newResolver[int64](s, p, vcs)
+
+	// Each pipeline creates a different, but not conflicting, instrument.
+	aggs, err := r.Aggregators(instProviderKey{Name: "foo", Kind: view.SyncCounter})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, l.InfoN(), "no info logging should happen")
+	assert.Len(t, aggs, 2)
+
+	// Conflicts are still detected within each pipeline.
+	aggs, err = r.Aggregators(instProviderKey{Name: "foo", Description: "conflicting", Kind: view.SyncCounter})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, l.InfoN(), "instrument conflicts not logged")
+	assert.Len(t, aggs, 2)
+}
@@ -24,6 +24,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/instrument"
 	"go.opentelemetry.io/otel/metric/unit"
 	"go.opentelemetry.io/otel/sdk/instrumentation"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
@@ -128,6 +130,23 @@ func TestPipelineConcurrency(t *testing.T) {
 	wg.Wait()
 }
 
+func TestObserveAfterCallbackRunEnded(t *testing.T) {
+	var n int
+	f := func() { n++ }
+
+	observe(context.Background(), f)
+	assert.Equal(t, 0, n, "observation outside of a callback")
+
+	r := &callbackRun{}
+	ctx := context.WithValue(context.Background(), produceKey, r)
+	observe(ctx, f)
+	assert.Equal(t, 1, n, "observation during a callback")
+
+	r.end()
+	observe(ctx, f)
+	assert.Equal(t, 1, n, "observation after the callback ended")
+}
+
 func TestDefaultViewImplicit(t *testing.T) {
 	t.Run("Int64", testDefaultViewImplicit[int64]())
 	t.Run("Float64", testDefaultViewImplicit[float64]())
@@ -168,7 +187,7 @@ func testDefaultViewImplicit[N int64 | float64]() func(t *testing.T) {
 				require.NoError(t, err)
 				assert.Len(t, got, 1, "default view not applied")
 				for _, a := range got {
-					a.Aggregate(1, *attribute.EmptySet())
+					a.Aggregate(context.Background(), 1, *attribute.EmptySet())
 				}
 
 				out, err := test.pipe.produce(context.Background())
@@ -190,3 +209,105 @@ func testDefaultViewImplicit[N int64 | float64]() func(t *testing.T) {
 		}
 	}
 }
+
+func TestAsyncCounterDeltaTemporality(t *testing.T) {
+	alice := attribute.NewSet(attribute.String("user", "alice"))
+	bob := attribute.NewSet(attribute.String("user", "bob"))
+
+	type observation struct {
+		attr  attribute.Set
+		value int64
+	}
+	cycles := []struct {
+		name    string
+		observe []observation
+		want    []metricdata.DataPoint[int64]
+	}{
+		{
+			name:    "FirstObservation",
+			observe: []observation{{alice, 5}, {bob, 3}},
+			want:    []metricdata.DataPoint[int64]{{Attributes: alice, Value: 5}, {Attributes: bob, Value: 3}},
+		},
+		{
+			name:    "Increment",
+			observe: []observation{{alice, 8}, {bob, 3}},
+			want:    []metricdata.DataPoint[int64]{{Attributes: alice, Value: 3}, {Attributes: bob, Value: 0}},
+		},
+		{
+			name:    "ResetAndSkip",
+			observe: []observation{{alice, 2}},
+			want:    []metricdata.DataPoint[int64]{{Attributes: alice, Value: 2}},
+		},
+		{
+			// The change is relative to the value observed before the
+			// skipped cycle.
+			name:    "Reappear",
+			observe: []observation{{alice, 4}, {bob, 10}},
+			want:    []metricdata.DataPoint[int64]{{Attributes: alice, Value: 2}, {Attributes: bob, Value: 7}},
+		},
+	}
+
+	rdr := NewManualReader(WithTemporalitySelector(deltaTemporalitySelector))
+	m := NewMeterProvider(WithReader(rdr)).Meter("TestAsyncCounterDeltaTemporality")
+	ctr, err := m.AsyncInt64().Counter("counter")
+	require.NoError(t, err)
+
+	var cycle int
+	_, err = m.RegisterCallback([]instrument.Asynchronous{ctr}, func(_ context.Context, o metric.Observer) {
+		for _, obs := range cycles[cycle].observe {
+			o.ObserveInt64(ctr, obs.value, obs.attr.ToSlice()...)
+		}
+	})
+	require.NoError(t, err)
+
+	for ; cycle < len(cycles); cycle++ {
+		c := cycles[cycle]
+		rm, err := rdr.Collect(context.Background())
+		require.NoError(t, err, c.name)
+		require.Len(t, rm.ScopeMetrics, 1, c.name)
+		require.Len(t, rm.ScopeMetrics[0].Metrics, 1, c.name)
+
+		want := metricdata.Metrics{
+			Name: "counter",
+			Data: metricdata.Sum[int64]{
+				Temporality: metricdata.DeltaTemporality,
+				IsMonotonic: true,
+				DataPoints:  c.want,
+			},
+		}
+		metricdatatest.AssertEqual(t, want, rm.ScopeMetrics[0].Metrics[0], metricdatatest.IgnoreTimestamp())
+	}
+}
+
+func TestAsyncUpDownCounterDeltaTemporality(t *testing.T) {
+	rdr := NewManualReader(WithTemporalitySelector(deltaTemporalitySelector))
+	m := NewMeterProvider(WithReader(rdr)).Meter("TestAsyncUpDownCounterDeltaTemporality")
+	ctr, err := m.AsyncFloat64().UpDownCounter("updowncounter")
+	require.NoError(t, err)
+
+	observations := []float64{5, 2, 2.5}
+	wants := []float64{5, -3, 0.5}
+
+	var cycle int
+	_, err = m.RegisterCallback([]instrument.Asynchronous{ctr}, func(_ context.Context, o metric.Observer) {
+		o.ObserveFloat64(ctr, observations[cycle])
+	})
+	require.NoError(t, err)
+
+	for ; cycle < len(observations); cycle++ {
+		rm, err := rdr.Collect(context.Background())
+		require.NoError(t, err)
+		require.Len(t, rm.ScopeMetrics, 1)
+		require.Len(t, rm.ScopeMetrics[0].Metrics, 1)
+
+		want := metricdata.Metrics{
+			Name: "updowncounter",
+			Data: metricdata.Sum[float64]{
+				Temporality: metricdata.DeltaTemporality,
+				IsMonotonic: false,
+				DataPoints:  []metricdata.DataPoint[float64]{{Attributes: *attribute.EmptySet(), Value: wants[cycle]}},
+			},
+		}
+		metricdatatest.AssertEqual(t, want, rm.ScopeMetrics[0].Metrics[0], metricdatatest.IgnoreTimestamp())
+	}
+}
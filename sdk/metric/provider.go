@@ -23,8 +23,8 @@ import (
 
 // MeterProvider handles the creation and coordination of Meters. All Meters
 // created by a MeterProvider will be associated with the same Resource, have
-// the same Views applied to them, and have their produced metric telemetry
-// passed to the configured Readers.
+// the same Views applied to them for each Reader, and have their produced
+// metric telemetry passed to the configured Readers.
 type MeterProvider struct {
 	pipes  pipelines
 	meters cache[instrumentation.Scope, *meter]
@@ -45,7 +45,7 @@ func NewMeterProvider(options ...Option) *MeterProvider {
 	conf := newConfig(options)
 	flush, sdown := conf.readerSignals()
 	return &MeterProvider{
-		pipes:      newPipelines(conf.res, conf.readers, conf.views),
+		pipes:      newPipelines(conf.res, conf.readers, conf.views, conf.readerViews, conf.exemplarFilter, conf.cardinalityLimit, conf.callbackConf),
 		forceFlush: flush,
 		shutdown:   sdown,
 	}
@@ -16,8 +16,10 @@ package metric // import "go.opentelemetry.io/otel/sdk/metric"
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/sdk/metric/aggregation"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 	"go.opentelemetry.io/otel/sdk/metric/view"
@@ -93,6 +95,69 @@ type producer interface {
 	produce(context.Context) (metricdata.ResourceMetrics, error)
 }
 
+// Producer produces metrics for a Reader from an external source.
+type Producer interface {
+	// Produce returns aggregated metrics from an external source.
+	//
+	// This method should be safe to call concurrently.
+	Produce(context.Context) ([]metricdata.ScopeMetrics, error)
+}
+
+// errExternalProducer is sent to the OpenTelemetry ErrorHandler when an
+// external Producer fails to produce metrics.
+var errExternalProducer = errors.New("external producer failed")
+
+// produceExternal appends the ScopeMetrics produced by each of producers to
+// rm. A failed Producer does not prevent the metrics of the others from being
+// appended, its error is sent to the OpenTelemetry ErrorHandler.
+func produceExternal(ctx context.Context, producers []Producer, rm *metricdata.ResourceMetrics) {
+	for _, p := range producers {
+		sm, err := p.Produce(ctx)
+		if err != nil {
+			otel.Handle(fmt.Errorf("%w: %v", errExternalProducer, err))
+			continue
+		}
+		rm.ScopeMetrics = append(rm.ScopeMetrics, sm...)
+	}
+}
+
+// ReaderOption is an option which can be applied to both a ManualReader and a
+// PeriodicReader.
+type ReaderOption interface {
+	ManualReaderOption
+	PeriodicReaderOption
+}
+
+// WithProducer registers p as an external Producer of metric data for a
+// Reader. The metric data p produces is merged with the metric data the
+// Reader collects from the SDK every time it collects.
+//
+// This option can be used multiple times to register multiple Producers. A
+// nil Producer is ignored.
+func WithProducer(p Producer) ReaderOption {
+	return producerOption{p: p}
+}
+
+type producerOption struct {
+	p Producer
+}
+
+// applyManual returns a manualReaderConfig with option applied.
+func (o producerOption) applyManual(c manualReaderConfig) manualReaderConfig {
+	if o.p != nil {
+		c.producers = append(c.producers, o.p)
+	}
+	return c
+}
+
+// applyPeriodic returns a periodicReaderConfig with option applied.
+func (o producerOption) applyPeriodic(c periodicReaderConfig) periodicReaderConfig {
+	if o.p != nil {
+		c.producers = append(c.producers, o.p)
+	}
+	return c
+}
+
 // produceHolder is used as an atomic.Value to wrap the non-concrete producer
 // type.
 type produceHolder struct {
@@ -126,12 +191,12 @@ type AggregationSelector func(view.InstrumentKind) aggregation.Aggregation
 // InstrumentKind. This AggregationSelector using the following selection
 // mapping: Counter ⇨ Sum, Asynchronous Counter ⇨ Sum, UpDownCounter ⇨ Sum,
 // Asynchronous UpDownCounter ⇨ Sum, Asynchronous Gauge ⇨ LastValue,
-// Histogram ⇨ ExplicitBucketHistogram.
+// Histogram ⇨ ExplicitBucketHistogram, Gauge ⇨ LastValue.
 func DefaultAggregationSelector(ik view.InstrumentKind) aggregation.Aggregation {
 	switch ik {
 	case view.SyncCounter, view.SyncUpDownCounter, view.AsyncCounter, view.AsyncUpDownCounter:
 		return aggregation.Sum{}
-	case view.AsyncGauge:
+	case view.AsyncGauge, view.SyncGauge:
 		return aggregation.LastValue{}
 	case view.SyncHistogram:
 		return aggregation.ExplicitBucketHistogram{
@@ -36,7 +36,7 @@ import (
 type readerTestSuite struct {
 	suite.Suite
 
-	Factory func() Reader
+	Factory func(...ReaderOption) Reader
 	Reader  Reader
 }
 
@@ -48,6 +48,13 @@ func (ts *readerTestSuite) SetupTest() {
 	ts.Reader = ts.Factory()
 }
 
+// reset replaces ts.Reader with a new Reader created with opts after shutting
+// down the current one.
+func (ts *readerTestSuite) reset(opts ...ReaderOption) {
+	_ = ts.Reader.Shutdown(context.Background())
+	ts.Reader = ts.Factory(opts...)
+}
+
 func (ts *readerTestSuite) TearDownTest() {
 	// Ensure Reader is allowed attempt to clean up.
 	_ = ts.Reader.Shutdown(context.Background())
@@ -65,6 +72,95 @@ func (ts *readerTestSuite) TestProducer() {
 	ts.Equal(testMetrics, m)
 }
 
+func (ts *readerTestSuite) TestExternalProducer() {
+	ts.reset(
+		WithProducer(testExternalProducer{}),
+		WithProducer(testExternalProducer{}),
+	)
+	ts.Reader.register(testProducer{})
+	m, err := ts.Reader.Collect(context.Background())
+	ts.NoError(err)
+
+	want := metricdata.ResourceMetrics{
+		Resource: testMetrics.Resource,
+		ScopeMetrics: []metricdata.ScopeMetrics{
+			testMetrics.ScopeMetrics[0],
+			testExternalScopeMetrics,
+			testExternalScopeMetrics,
+		},
+	}
+	ts.Equal(want, m)
+}
+
+func (ts *readerTestSuite) TestExternalProducerNil() {
+	ts.reset(WithProducer(nil), WithProducer(testExternalProducer{}))
+	ts.Reader.register(testProducer{})
+	var (
+		m   metricdata.ResourceMetrics
+		err error
+	)
+	ts.Require().NotPanics(func() { m, err = ts.Reader.Collect(context.Background()) })
+	ts.NoError(err)
+
+	want := metricdata.ResourceMetrics{
+		Resource: testMetrics.Resource,
+		ScopeMetrics: []metricdata.ScopeMetrics{
+			testMetrics.ScopeMetrics[0],
+			testExternalScopeMetrics,
+		},
+	}
+	ts.Equal(want, m)
+}
+
+func (ts *readerTestSuite) TestExternalProducerError() {
+	var handled []error
+	setErrorHandler(ts.T(), otel.ErrorHandlerFunc(func(err error) {
+		handled = append(handled, err)
+	}))
+
+	ts.reset(
+		WithProducer(testExternalProducer{
+			produceFunc: func(context.Context) ([]metricdata.ScopeMetrics, error) {
+				return nil, assert.AnError
+			},
+		}),
+		WithProducer(testExternalProducer{}),
+	)
+	ts.Reader.register(testProducer{})
+	m, err := ts.Reader.Collect(context.Background())
+	ts.NoError(err)
+
+	want := metricdata.ResourceMetrics{
+		Resource: testMetrics.Resource,
+		ScopeMetrics: []metricdata.ScopeMetrics{
+			testMetrics.ScopeMetrics[0],
+			testExternalScopeMetrics,
+		},
+	}
+	ts.Equal(want, m)
+	if ts.Len(handled, 1) {
+		ts.ErrorIs(handled[0], errExternalProducer)
+	}
+}
+
+func (ts *readerTestSuite) TestExternalProducerNotCalledOnSDKError() {
+	var called bool
+	ts.reset(WithProducer(testExternalProducer{
+		produceFunc: func(context.Context) ([]metricdata.ScopeMetrics, error) {
+			called = true
+			return nil, nil
+		},
+	}))
+	ts.Reader.register(testProducer{
+		produceFunc: func(context.Context) (metricdata.ResourceMetrics, error) {
+			return metricdata.ResourceMetrics{}, assert.AnError
+		},
+	})
+	_, err := ts.Reader.Collect(context.Background())
+	ts.ErrorIs(err, assert.AnError)
+	ts.False(called, "external producer called after SDK produce error")
+}
+
 func (ts *readerTestSuite) TestCollectAfterShutdown() {
 	ctx := context.Background()
 	ts.Reader.register(testProducer{})
@@ -182,6 +278,33 @@ func (p testProducer) produce(ctx context.Context) (metricdata.ResourceMetrics,
 	return testMetrics, nil
 }
 
+var testExternalScopeMetrics = metricdata.ScopeMetrics{
+	Scope: instrumentation.Scope{Name: "sdk/metric/test/reader/external"},
+	Metrics: []metricdata.Metrics{{
+		Name:        "fake external data",
+		Description: "Data used to test a Producer",
+		Unit:        unit.Dimensionless,
+		Data: metricdata.Gauge[int64]{
+			DataPoints: []metricdata.DataPoint[int64]{{
+				Attributes: attribute.NewSet(attribute.String("user", "bob")),
+				Time:       time.Now(),
+				Value:      1,
+			}},
+		},
+	}},
+}
+
+type testExternalProducer struct {
+	produceFunc func(context.Context) ([]metricdata.ScopeMetrics, error)
+}
+
+func (p testExternalProducer) Produce(ctx context.Context) ([]metricdata.ScopeMetrics, error) {
+	if p.produceFunc != nil {
+		return p.produceFunc(ctx)
+	}
+	return []metricdata.ScopeMetrics{testExternalScopeMetrics}, nil
+}
+
 func benchReaderCollectFunc(r Reader) func(*testing.B) {
 	ctx := context.Background()
 	r.register(testProducer{})
@@ -215,6 +338,7 @@ func TestDefaultAggregationSelector(t *testing.T) {
 		view.AsyncCounter,
 		view.AsyncUpDownCounter,
 		view.AsyncGauge,
+		view.SyncGauge,
 	}
 
 	for _, ik := range iKinds {
@@ -232,6 +356,7 @@ func TestDefaultTemporalitySelector(t *testing.T) {
 		view.AsyncCounter,
 		view.AsyncUpDownCounter,
 		view.AsyncGauge,
+		view.SyncGauge,
 	} {
 		assert.Equal(t, metricdata.CumulativeTemporality, DefaultTemporalitySelector(ik))
 	}
@@ -0,0 +1,27 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package selfobservability provides observers that report the operation of
+// OpenTelemetry SDK components as metrics.
+//
+// The stable SDK components do not depend on the metric API. Instead, they
+// accept observers notified of their operation, e.g. the
+// BatchSpanProcessorObserver of the go.opentelemetry.io/otel/sdk/trace
+// package. This package provides implementations of these observers that
+// record the observations with a MeterProvider.
+//
+// The MeterProvider passed to these observers should be separate from any
+// pipeline the observed component is a part of. Otherwise, the component
+// reports on the processing of its own reports.
+package selfobservability // import "go.opentelemetry.io/otel/sdk/metric/selfobservability"
@@ -0,0 +1,142 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package selfobservability // import "go.opentelemetry.io/otel/sdk/metric/selfobservability"
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/instrument"
+	"go.opentelemetry.io/otel/metric/instrument/syncfloat64"
+	"go.opentelemetry.io/otel/metric/instrument/syncint64"
+	"go.opentelemetry.io/otel/metric/unit"
+	"go.opentelemetry.io/otel/sdk/trace"
+)
+
+// instrumentationName is the name of the instrumentation scope observations
+// are recorded with.
+const instrumentationName = "go.opentelemetry.io/otel/sdk/metric/selfobservability"
+
+// spanProcessorObserver records the operation of a batch span processor.
+type spanProcessorObserver struct {
+	queued   syncint64.Counter
+	dropped  syncint64.Counter
+	exported syncint64.Counter
+}
+
+var _ trace.BatchSpanProcessorObserver = (*spanProcessorObserver)(nil)
+
+// NewBatchSpanProcessorObserver returns a BatchSpanProcessorObserver that
+// records the number of spans a batch span processor queued, dropped, and
+// exported with instruments created from mp.
+//
+// The returned observer is passed to a batch span processor with the
+// WithObserver option of the go.opentelemetry.io/otel/sdk/trace package.
+func NewBatchSpanProcessorObserver(mp metric.MeterProvider) (trace.BatchSpanProcessorObserver, error) {
+	provider := mp.Meter(instrumentationName).SyncInt64()
+	queued, err := provider.Counter(
+		"otel.sdk.span_processor.spans_queued",
+		instrument.WithUnit(unit.Dimensionless),
+		instrument.WithDescription("The number of spans queued to be exported"),
+	)
+	if err != nil {
+		return nil, err
+	}
+	dropped, err := provider.Counter(
+		"otel.sdk.span_processor.spans_dropped",
+		instrument.WithUnit(unit.Dimensionless),
+		instrument.WithDescription("The number of spans dropped because the queue was full"),
+	)
+	if err != nil {
+		return nil, err
+	}
+	exported, err := provider.Counter(
+		"otel.sdk.span_processor.spans_exported",
+		instrument.WithUnit(unit.Dimensionless),
+		instrument.WithDescription("The number of spans successfully exported"),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &spanProcessorObserver{
+		queued:   queued,
+		dropped:  dropped,
+		exported: exported,
+	}, nil
+}
+
+func (o *spanProcessorObserver) SpansQueued(ctx context.Context, n int) {
+	o.queued.Add(ctx, int64(n))
+}
+
+func (o *spanProcessorObserver) SpansDropped(ctx context.Context, n int) {
+	o.dropped.Add(ctx, int64(n))
+}
+
+func (o *spanProcessorObserver) SpansExported(ctx context.Context, n int) {
+	o.exported.Add(ctx, int64(n))
+}
+
+// ExportObserver records the duration and failures of the exports made by an
+// OTLP exporter client.
+//
+// It implements the ExportObserver interfaces of the
+// go.opentelemetry.io/otel/exporters/otlp/otlptrace and
+// go.opentelemetry.io/otel/exporters/otlp/otlpmetric packages, and is passed
+// to a client with the WithExportObserver option of that client.
+type ExportObserver struct {
+	duration syncfloat64.Histogram
+	failures syncint64.Counter
+	attrs    []attribute.KeyValue
+}
+
+// NewExportObserver returns an ExportObserver that records the exports of
+// the client identified by name with instruments created from mp. The name
+// is recorded as the "exporter" attribute of all measurements.
+func NewExportObserver(mp metric.MeterProvider, name string) (*ExportObserver, error) {
+	m := mp.Meter(instrumentationName)
+	duration, err := m.SyncFloat64().Histogram(
+		"otel.exporter.otlp.export.duration",
+		instrument.WithUnit(unit.Milliseconds),
+		instrument.WithDescription("The duration of exports, including retries"),
+	)
+	if err != nil {
+		return nil, err
+	}
+	failures, err := m.SyncInt64().Counter(
+		"otel.exporter.otlp.export.failures",
+		instrument.WithUnit(unit.Dimensionless),
+		instrument.WithDescription("The number of exports that failed"),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &ExportObserver{
+		duration: duration,
+		failures: failures,
+		attrs:    []attribute.KeyValue{attribute.String("exporter", name)},
+	}, nil
+}
+
+// ExportDone records an export that took duration and completed with err.
+func (o *ExportObserver) ExportDone(ctx context.Context, duration time.Duration, err error) {
+	elapsed := float64(duration) / float64(time.Millisecond)
+	o.duration.Record(ctx, elapsed, o.attrs...)
+	if err != nil {
+		o.failures.Add(ctx, 1, o.attrs...)
+	}
+}
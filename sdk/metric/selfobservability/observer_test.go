@@ -0,0 +1,114 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package selfobservability
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric/unit"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+)
+
+func collect(t *testing.T, r sdkmetric.Reader) map[string]metricdata.Metrics {
+	t.Helper()
+
+	rm, err := r.Collect(context.Background())
+	require.NoError(t, err)
+	require.Len(t, rm.ScopeMetrics, 1)
+	assert.Equal(t, instrumentationName, rm.ScopeMetrics[0].Scope.Name)
+
+	got := make(map[string]metricdata.Metrics)
+	for _, m := range rm.ScopeMetrics[0].Metrics {
+		got[m.Name] = m
+	}
+	return got
+}
+
+func counter(name, desc string, value int64, attrs ...attribute.KeyValue) metricdata.Metrics {
+	return metricdata.Metrics{
+		Name:        name,
+		Description: desc,
+		Unit:        unit.Dimensionless,
+		Data: metricdata.Sum[int64]{
+			Temporality: metricdata.CumulativeTemporality,
+			IsMonotonic: true,
+			DataPoints: []metricdata.DataPoint[int64]{{
+				Attributes: attribute.NewSet(attrs...),
+				Value:      value,
+			}},
+		},
+	}
+}
+
+func TestBatchSpanProcessorObserver(t *testing.T) {
+	r := sdkmetric.NewManualReader()
+	mp := sdkmetric.NewMeterProvider(sdkmetric.WithReader(r))
+	o, err := NewBatchSpanProcessorObserver(mp)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	o.SpansQueued(ctx, 3)
+	o.SpansQueued(ctx, 1)
+	o.SpansDropped(ctx, 1)
+	o.SpansExported(ctx, 2)
+
+	got := collect(t, r)
+	want := []metricdata.Metrics{
+		counter("otel.sdk.span_processor.spans_queued", "The number of spans queued to be exported", 4),
+		counter("otel.sdk.span_processor.spans_dropped", "The number of spans dropped because the queue was full", 1),
+		counter("otel.sdk.span_processor.spans_exported", "The number of spans successfully exported", 2),
+	}
+	for _, w := range want {
+		metricdatatest.AssertEqual(t, w, got[w.Name], metricdatatest.IgnoreTimestamp())
+	}
+}
+
+func TestExportObserver(t *testing.T) {
+	r := sdkmetric.NewManualReader()
+	mp := sdkmetric.NewMeterProvider(sdkmetric.WithReader(r))
+	o, err := NewExportObserver(mp, "otlptracegrpc")
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	o.ExportDone(ctx, 2*time.Millisecond, nil)
+	o.ExportDone(ctx, 3*time.Millisecond, errors.New("export failed"))
+
+	got := collect(t, r)
+	attr := attribute.String("exporter", "otlptracegrpc")
+
+	metricdatatest.AssertEqual(t, counter(
+		"otel.exporter.otlp.export.failures",
+		"The number of exports that failed",
+		1, attr,
+	), got["otel.exporter.otlp.export.failures"], metricdatatest.IgnoreTimestamp())
+
+	duration := got["otel.exporter.otlp.export.duration"]
+	assert.Equal(t, unit.Milliseconds, duration.Unit)
+	require.IsType(t, metricdata.Histogram{}, duration.Data)
+	dPts := duration.Data.(metricdata.Histogram).DataPoints
+	require.Len(t, dPts, 1)
+	assert.Equal(t, attribute.NewSet(attr), dPts[0].Attributes)
+	assert.Equal(t, uint64(2), dPts[0].Count)
+	assert.Equal(t, 5.0, dPts[0].Sum)
+}
@@ -17,6 +17,7 @@ package view // import "go.opentelemetry.io/otel/sdk/metric/view"
 import (
 	"fmt"
 
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/sdk/instrumentation"
 	"go.opentelemetry.io/otel/sdk/metric/aggregation"
 )
@@ -194,3 +195,39 @@ func ExampleWithSetAggregation() {
 	fmt.Printf("Instrument{%q}: %#v\n", i.Name, i.Aggregation)
 	// Output: Instrument{"active-users"}: aggregation.Drop{}
 }
+
+func ExampleWithTransformAttributes() {
+	// Reduce the cardinality of the "http.status_code" attribute by bucketing
+	// its values into status classes, and remove any "user.id" attribute.
+	v, err := New(
+		MatchInstrumentName("http.server.duration"),
+		WithTransformAttributes(func(kv attribute.KeyValue) (attribute.KeyValue, bool) {
+			switch kv.Key {
+			case "http.status_code":
+				return kv.Key.String(fmt.Sprintf("%dxx", kv.Value.AsInt64()/100)), true
+			case "user.id":
+				return kv, false
+			}
+			return kv, true
+		}),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	// The SDK applies the AttributeFilter to the attributes of each
+	// measurement before it is aggregated.
+	filter := v.AttributeFilter()
+	for _, attrs := range []attribute.Set{
+		attribute.NewSet(attribute.Int("http.status_code", 200), attribute.String("user.id", "alice")),
+		attribute.NewSet(attribute.Int("http.status_code", 204), attribute.String("user.id", "bob")),
+		attribute.NewSet(attribute.Int("http.status_code", 503)),
+	} {
+		out := filter(attrs)
+		fmt.Println(out.Encoded(attribute.DefaultEncoder()))
+	}
+	// Output:
+	// http.status_code=2xx
+	// http.status_code=2xx
+	// http.status_code=5xx
+}
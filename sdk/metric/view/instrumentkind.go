@@ -40,4 +40,7 @@ const (
 	// AsyncGauge is an instrument kind that records current values in an
 	// asynchronous callback.
 	AsyncGauge
+	// SyncGauge is an instrument kind that records current values
+	// synchronously in application code.
+	SyncGauge
 )
@@ -23,6 +23,7 @@ import (
 	"go.opentelemetry.io/otel/internal/global"
 	"go.opentelemetry.io/otel/sdk/instrumentation"
 	"go.opentelemetry.io/otel/sdk/metric/aggregation"
+	"go.opentelemetry.io/otel/sdk/metric/exemplar"
 )
 
 // View provides users with the flexibility to customize the metrics that are
@@ -38,9 +39,12 @@ type View struct {
 	instrumentKind InstrumentKind
 
 	filter      attribute.Filter
+	transform   func(attribute.KeyValue) (attribute.KeyValue, bool)
 	name        string
 	description string
 	agg         aggregation.Aggregation
+	reservoir   exemplar.ReservoirProvider
+	limit       int
 }
 
 // New returns a new configured View. If there are any duplicate Options passed,
@@ -88,17 +92,46 @@ func (v View) TransformInstrument(inst Instrument) (transformed Instrument, matc
 }
 
 // AttributeFilter returns a function that returns only attributes specified by
-// WithFilterAttributes. If no filter was provided nil is returned.
+// WithFilterAttributes, transformed by the function specified by
+// WithTransformAttributes. If neither was provided nil is returned.
 func (v View) AttributeFilter() func(attribute.Set) attribute.Set {
-	if v.filter == nil {
+	switch {
+	case v.filter == nil && v.transform == nil:
 		return nil
+	case v.transform == nil:
+		return func(input attribute.Set) attribute.Set {
+			out, _ := input.Filter(v.filter)
+			return out
+		}
 	}
 	return func(input attribute.Set) attribute.Set {
-		out, _ := input.Filter(v.filter)
-		return out
+		kvs := make([]attribute.KeyValue, 0, input.Len())
+		for iter := input.Iter(); iter.Next(); {
+			kv := iter.Attribute()
+			if v.filter != nil && !v.filter(kv) {
+				continue
+			}
+			if kv, ok := v.transform(kv); ok && kv.Valid() {
+				kvs = append(kvs, kv)
+			}
+		}
+		return attribute.NewSet(kvs...)
 	}
 }
 
+// ExemplarReservoir returns the ReservoirProvider specified by
+// WithExemplarReservoir. If no ReservoirProvider was provided nil is
+// returned.
+func (v View) ExemplarReservoir() exemplar.ReservoirProvider {
+	return v.reservoir
+}
+
+// CardinalityLimit returns the cardinality limit specified by
+// WithCardinalityLimit. If no limit was provided 0 is returned.
+func (v View) CardinalityLimit() int {
+	return v.limit
+}
+
 func (v View) matchName(name string) bool {
 	return v.instrumentName == nil || v.instrumentName.MatchString(name)
 }
@@ -213,6 +246,28 @@ func WithFilterAttributes(keys ...attribute.Key) Option {
 	})
 }
 
+// WithTransformAttributes will replace each attribute of the measurements
+// made for matching instruments with the attribute fn returns for it, or
+// remove the attribute if fn returns false. This can be used to rename
+// attribute keys, rewrite attribute values, or remove attributes based on
+// their values. If used together with WithFilterAttributes, fn is only
+// called for the attributes that filter selects. If not used or fn is nil
+// no transformation will be applied.
+//
+// Attributes fn returns with an invalid key are removed. If fn returns
+// multiple attributes with the same key for a measurement, only one of them
+// is kept.
+//
+// The transformation is applied before measurements are aggregated and its
+// result for an attribute set may be cached. Therefore, fn needs to return
+// the same result when called with the same attribute.
+func WithTransformAttributes(fn func(attribute.KeyValue) (attribute.KeyValue, bool)) Option {
+	return optionFunc(func(v View) View {
+		v.transform = fn
+		return v
+	})
+}
+
 // WithSetAggregation will use the aggregation a for matching instruments. If
 // this option is not provided, the reader defined aggregation for the
 // instrument will be used.
@@ -230,3 +285,27 @@ func WithSetAggregation(a aggregation.Aggregation) Option {
 		return v
 	})
 }
+
+// WithExemplarReservoir will use the ReservoirProvider p to create the
+// Reservoirs that sample exemplars for matching instruments. If this option
+// is not provided, or p is nil, the default Reservoir for the aggregation of
+// the instrument will be used.
+func WithExemplarReservoir(p exemplar.ReservoirProvider) Option {
+	return optionFunc(func(v View) View {
+		v.reservoir = p
+		return v
+	})
+}
+
+// WithCardinalityLimit will limit the number of distinct attribute sets
+// aggregated for matching instruments to limit. If this option is not
+// provided, or limit is not positive, the limit configured for the
+// MeterProvider will be used.
+func WithCardinalityLimit(limit int) Option {
+	return optionFunc(func(v View) View {
+		if limit > 0 {
+			v.limit = limit
+		}
+		return v
+	})
+}
@@ -22,6 +22,7 @@ import (
 
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/metric/exemplar"
 )
 
 var matchInstrument = Instrument{
@@ -342,6 +343,34 @@ func TestViewAttributeFilterNoFilter(t *testing.T) {
 	assert.Nil(t, filter)
 }
 
+func TestViewExemplarReservoir(t *testing.T) {
+	v, err := New(MatchInstrumentName("*"))
+	require.NoError(t, err)
+	assert.Nil(t, v.ExemplarReservoir())
+
+	res := exemplar.FixedSize(2)
+	v, err = New(
+		MatchInstrumentName("*"),
+		WithExemplarReservoir(res),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, res, v.ExemplarReservoir())
+}
+
+func TestViewCardinalityLimit(t *testing.T) {
+	v, err := New(MatchInstrumentName("*"))
+	require.NoError(t, err)
+	assert.Equal(t, 0, v.CardinalityLimit())
+
+	v, err = New(MatchInstrumentName("*"), WithCardinalityLimit(-1))
+	require.NoError(t, err)
+	assert.Equal(t, 0, v.CardinalityLimit())
+
+	v, err = New(MatchInstrumentName("*"), WithCardinalityLimit(10))
+	require.NoError(t, err)
+	assert.Equal(t, 10, v.CardinalityLimit())
+}
+
 func TestViewAttributeFilter(t *testing.T) {
 	inputSet := attribute.NewSet(
 		attribute.String("foo", "bar"),
@@ -408,6 +437,126 @@ func TestViewAttributeFilter(t *testing.T) {
 	}
 }
 
+func TestViewAttributeTransformNoTransform(t *testing.T) {
+	v, err := New(
+		MatchInstrumentName("*"),
+		WithTransformAttributes(nil),
+	)
+	require.NoError(t, err)
+	assert.Nil(t, v.AttributeFilter())
+}
+
+func TestViewAttributeTransform(t *testing.T) {
+	inputSet := attribute.NewSet(
+		attribute.String("foo", "bar"),
+		attribute.Int("power-level", 9001),
+		attribute.Float64("lifeUniverseEverything", 42.0),
+	)
+
+	tests := []struct {
+		name      string
+		filter    []attribute.Key
+		transform func(attribute.KeyValue) (attribute.KeyValue, bool)
+		want      attribute.Set
+	}{
+		{
+			name: "Identity",
+			transform: func(kv attribute.KeyValue) (attribute.KeyValue, bool) {
+				return kv, true
+			},
+			want: inputSet,
+		},
+		{
+			name: "Drop all",
+			transform: func(kv attribute.KeyValue) (attribute.KeyValue, bool) {
+				return kv, false
+			},
+			want: attribute.NewSet(),
+		},
+		{
+			name: "Drop by value",
+			transform: func(kv attribute.KeyValue) (attribute.KeyValue, bool) {
+				return kv, kv.Value.Type() != attribute.INT64 || kv.Value.AsInt64() < 9000
+			},
+			want: attribute.NewSet(
+				attribute.String("foo", "bar"),
+				attribute.Float64("lifeUniverseEverything", 42.0),
+			),
+		},
+		{
+			name: "Rename",
+			transform: func(kv attribute.KeyValue) (attribute.KeyValue, bool) {
+				if kv.Key == "foo" {
+					kv.Key = "baz"
+				}
+				return kv, true
+			},
+			want: attribute.NewSet(
+				attribute.String("baz", "bar"),
+				attribute.Int("power-level", 9001),
+				attribute.Float64("lifeUniverseEverything", 42.0),
+			),
+		},
+		{
+			name: "Rewrite value",
+			transform: func(kv attribute.KeyValue) (attribute.KeyValue, bool) {
+				if kv.Key == "power-level" {
+					return kv.Key.String("over 9000"), true
+				}
+				return kv, true
+			},
+			want: attribute.NewSet(
+				attribute.String("foo", "bar"),
+				attribute.String("power-level", "over 9000"),
+				attribute.Float64("lifeUniverseEverything", 42.0),
+			),
+		},
+		{
+			name: "Invalid key",
+			transform: func(kv attribute.KeyValue) (attribute.KeyValue, bool) {
+				if kv.Key == "foo" {
+					kv.Key = ""
+				}
+				return kv, true
+			},
+			want: attribute.NewSet(
+				attribute.Int("power-level", 9001),
+				attribute.Float64("lifeUniverseEverything", 42.0),
+			),
+		},
+		{
+			name:   "With filter",
+			filter: []attribute.Key{"foo", "power-level"},
+			transform: func(kv attribute.KeyValue) (attribute.KeyValue, bool) {
+				if kv.Key == "power-level" {
+					// Renamed keys are not removed by the filter.
+					kv.Key = "level"
+				}
+				return kv, true
+			},
+			want: attribute.NewSet(
+				attribute.String("foo", "bar"),
+				attribute.Int("level", 9001),
+			),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := New(
+				MatchInstrumentName("*"),
+				WithFilterAttributes(tt.filter...),
+				WithTransformAttributes(tt.transform),
+			)
+			require.NoError(t, err)
+			filter := v.AttributeFilter()
+			require.NotNil(t, filter)
+
+			got := filter(inputSet)
+			assert.Equal(t, tt.want.Equivalent(), got.Equivalent())
+		})
+	}
+}
+
 func TestNewErrors(t *testing.T) {
 	tests := []struct {
 		name    string
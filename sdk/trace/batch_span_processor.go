@@ -67,6 +67,26 @@ type BatchSpanProcessorOptions struct {
 	// Blocking option should be used carefully as it can severely affect the performance of an
 	// application.
 	BlockOnQueueFull bool
+
+	// observer is notified of the operation of the BatchSpanProcessor. If
+	// nil, nothing is observed.
+	observer BatchSpanProcessorObserver
+}
+
+// BatchSpanProcessorObserver is notified of the spans a BatchSpanProcessor
+// queues, drops, and exports. It can be used to report the operation of a
+// BatchSpanProcessor, e.g. as metrics.
+//
+// The methods are called synchronously while spans are processed. They need
+// to be safe to call concurrently and to return quickly.
+type BatchSpanProcessorObserver interface {
+	// SpansQueued is called when n spans are queued to be exported.
+	SpansQueued(ctx context.Context, n int)
+	// SpansDropped is called when n spans are dropped because the queue
+	// is full.
+	SpansDropped(ctx context.Context, n int)
+	// SpansExported is called when n spans are successfully exported.
+	SpansExported(ctx context.Context, n int)
 }
 
 // batchSpanProcessor is a SpanProcessor that batches asynchronously-received
@@ -75,8 +95,9 @@ type batchSpanProcessor struct {
 	e SpanExporter
 	o BatchSpanProcessorOptions
 
-	queue   chan ReadOnlySpan
-	dropped uint32
+	queue    chan ReadOnlySpan
+	dropped  uint32
+	observer BatchSpanProcessorObserver
 
 	batch      []ReadOnlySpan
 	batchMutex sync.Mutex
@@ -114,12 +135,13 @@ func NewBatchSpanProcessor(exporter SpanExporter, options ...BatchSpanProcessorO
 		opt(&o)
 	}
 	bsp := &batchSpanProcessor{
-		e:      exporter,
-		o:      o,
-		batch:  make([]ReadOnlySpan, 0, o.MaxExportBatchSize),
-		timer:  time.NewTimer(o.BatchTimeout),
-		queue:  make(chan ReadOnlySpan, o.MaxQueueSize),
-		stopCh: make(chan struct{}),
+		e:        exporter,
+		o:        o,
+		observer: o.observer,
+		batch:    make([]ReadOnlySpan, 0, o.MaxExportBatchSize),
+		timer:    time.NewTimer(o.BatchTimeout),
+		queue:    make(chan ReadOnlySpan, o.MaxQueueSize),
+		stopCh:   make(chan struct{}),
 	}
 
 	bsp.stopWait.Add(1)
@@ -251,6 +273,20 @@ func WithBlocking() BatchSpanProcessorOption {
 	}
 }
 
+// WithObserver returns a BatchSpanProcessorOption that configures the
+// BatchSpanProcessorObserver notified of the spans a BatchSpanProcessor
+// queues, drops, and exports.
+//
+// The BatchSpanProcessor never creates spans of its own, observing it does
+// not feed back into it.
+//
+// By default, if this option is not used, nothing is observed.
+func WithObserver(o BatchSpanProcessorObserver) BatchSpanProcessorOption {
+	return func(opts *BatchSpanProcessorOptions) {
+		opts.observer = o
+	}
+}
+
 // exportSpans is a subroutine of processing and draining the queue.
 func (bsp *batchSpanProcessor) exportSpans(ctx context.Context) error {
 	bsp.timer.Reset(bsp.o.BatchTimeout)
@@ -277,6 +313,9 @@ func (bsp *batchSpanProcessor) exportSpans(ctx context.Context) error {
 		if err != nil {
 			return err
 		}
+		if bsp.observer != nil {
+			bsp.observer.SpansExported(ctx, l)
+		}
 	}
 	return nil
 }
@@ -388,6 +427,9 @@ func (bsp *batchSpanProcessor) enqueueBlockOnQueueFull(ctx context.Context, sd R
 
 	select {
 	case bsp.queue <- sd:
+		if _, ok := sd.(forceFlushSpan); !ok && bsp.observer != nil {
+			bsp.observer.SpansQueued(ctx, 1)
+		}
 		return true
 	case <-ctx.Done():
 		return false
@@ -411,9 +453,15 @@ func (bsp *batchSpanProcessor) enqueueDrop(ctx context.Context, sd ReadOnlySpan)
 
 	select {
 	case bsp.queue <- sd:
+		if bsp.observer != nil {
+			bsp.observer.SpansQueued(ctx, 1)
+		}
 		return true
 	default:
 		atomic.AddUint32(&bsp.dropped, 1)
+		if bsp.observer != nil {
+			bsp.observer.SpansDropped(ctx, 1)
+		}
 	}
 	return false
 }
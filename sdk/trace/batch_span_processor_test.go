@@ -594,6 +594,103 @@ func TestBatchSpanProcessorForceFlushQueuedSpans(t *testing.T) {
 	}
 }
 
+// countingObserver is a BatchSpanProcessorObserver that counts the spans it
+// is notified of.
+type countingObserver struct {
+	mu                        sync.Mutex
+	queued, dropped, exported int
+}
+
+func (o *countingObserver) SpansQueued(_ context.Context, n int) {
+	o.mu.Lock()
+	o.queued += n
+	o.mu.Unlock()
+}
+
+func (o *countingObserver) SpansDropped(_ context.Context, n int) {
+	o.mu.Lock()
+	o.dropped += n
+	o.mu.Unlock()
+}
+
+func (o *countingObserver) SpansExported(_ context.Context, n int) {
+	o.mu.Lock()
+	o.exported += n
+	o.mu.Unlock()
+}
+
+func (o *countingObserver) counts() (queued, dropped, exported int) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.queued, o.dropped, o.exported
+}
+
+// blockingExporter blocks all exports until release is closed.
+type blockingExporter struct {
+	testBatchExporter
+	release chan struct{}
+}
+
+func (e *blockingExporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	<-e.release
+	return e.testBatchExporter.ExportSpans(ctx, spans)
+}
+
+func TestBatchSpanProcessorObserver(t *testing.T) {
+	t.Run("Exported", func(t *testing.T) {
+		obs := &countingObserver{}
+		te := testBatchExporter{}
+		bsp := sdktrace.NewBatchSpanProcessor(&te, sdktrace.WithObserver(obs))
+		tp := basicTracerProvider(t)
+		tp.RegisterSpanProcessor(bsp)
+		generateSpan(t, tp.Tracer("BatchSpanProcessorObserver"), testOption{genNumSpans: 10})
+		require.NoError(t, bsp.ForceFlush(context.Background()))
+
+		queued, dropped, exported := obs.counts()
+		assert.Equal(t, 10, queued)
+		assert.Equal(t, 0, dropped)
+		assert.Equal(t, 10, exported)
+		assert.NoError(t, bsp.Shutdown(context.Background()))
+	})
+
+	t.Run("Dropped", func(t *testing.T) {
+		obs := &countingObserver{}
+		exp := &blockingExporter{release: make(chan struct{})}
+		bsp := sdktrace.NewBatchSpanProcessor(
+			exp,
+			sdktrace.WithObserver(obs),
+			sdktrace.WithMaxQueueSize(1),
+			sdktrace.WithMaxExportBatchSize(1),
+		)
+		tp := basicTracerProvider(t)
+		tp.RegisterSpanProcessor(bsp)
+		const n = 10
+		generateSpan(t, tp.Tracer("BatchSpanProcessorObserver"), testOption{genNumSpans: n})
+
+		// At most one span can be exporting and one can be queued.
+		queued, dropped, _ := obs.counts()
+		assert.GreaterOrEqual(t, dropped, n-2)
+		assert.Equal(t, n, queued+dropped)
+
+		close(exp.release)
+		require.NoError(t, bsp.Shutdown(context.Background()))
+		queued, _, exported := obs.counts()
+		assert.Equal(t, queued, exported)
+		assert.Equal(t, exported, exp.len())
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		// No observer is the default and must not panic.
+		te := testBatchExporter{}
+		bsp := sdktrace.NewBatchSpanProcessor(&te)
+		tp := basicTracerProvider(t)
+		tp.RegisterSpanProcessor(bsp)
+		generateSpan(t, tp.Tracer("BatchSpanProcessorObserver"), testOption{genNumSpans: 10})
+		assert.NoError(t, bsp.Shutdown(context.Background()))
+		assert.Equal(t, 10, te.len())
+	})
+}
+
 func BenchmarkSpanProcessor(b *testing.B) {
 	tp := sdktrace.NewTracerProvider(
 		sdktrace.WithBatcher(
@@ -0,0 +1,283 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trace // import "go.opentelemetry.io/otel/sdk/trace"
+
+import (
+	crand "crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"math/bits"
+	"math/rand"
+	"strconv"
+	"strings"
+	"sync"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// otelTraceStateKey is the tracestate key of the OpenTelemetry values.
+	otelTraceStateKey = "ot"
+
+	pValueKey = "p"
+	rValueKey = "r"
+
+	// maxPValue is the p-value of the zero probability.
+	maxPValue = 63
+	// maxRValue is the largest r-value.
+	maxRValue = 62
+	// unsetValue is the value of a missing p-value or r-value.
+	unsetValue = -1
+
+	// minConsistentFraction is the smallest non-zero probability that can
+	// be represented by a p-value.
+	minConsistentFraction = 0x1p-62
+)
+
+var (
+	errInvalidOTelTraceState = errors.New("invalid OpenTelemetry tracestate value")
+	errInconsistentPValue    = errors.New("inconsistent p-value")
+)
+
+// otelTraceState is the value of the OpenTelemetry tracestate entry.
+//
+// The p-value is the negative base-2 logarithm of the probability a span was
+// sampled with, it is unset if the span was not sampled. The r-value is the
+// randomness of the trace used to make consistent sampling decisions: a span
+// is sampled with a p-value if it is less than or equal to the r-value.
+type otelTraceState struct {
+	p, r int
+	// rest are the fields of the value other than the p-value and r-value.
+	rest []string
+}
+
+// parseOTelTraceState parses the OpenTelemetry tracestate value. Invalid
+// fields are dropped from the returned value and reported with an error.
+func parseOTelTraceState(value string) (otelTraceState, error) {
+	ots := otelTraceState{p: unsetValue, r: unsetValue}
+	if value == "" {
+		return ots, nil
+	}
+
+	var invalid []string
+	for _, field := range strings.Split(value, ";") {
+		key, v, ok := strings.Cut(field, ":")
+		if !ok {
+			invalid = append(invalid, field)
+			continue
+		}
+
+		var err error
+		switch key {
+		case pValueKey:
+			ots.p, err = parseOTelTraceStateValue(v, maxPValue)
+		case rValueKey:
+			ots.r, err = parseOTelTraceStateValue(v, maxRValue)
+		default:
+			ots.rest = append(ots.rest, field)
+		}
+		if err != nil {
+			invalid = append(invalid, field)
+		}
+	}
+
+	if len(invalid) > 0 {
+		return ots, fmt.Errorf("%w: %s", errInvalidOTelTraceState, strings.Join(invalid, ";"))
+	}
+	return ots, nil
+}
+
+// parseOTelTraceStateValue parses a p-value or r-value that is valid if it
+// is at most limit. The unsetValue is returned if it is invalid.
+func parseOTelTraceStateValue(v string, limit int) (int, error) {
+	n, err := strconv.ParseUint(v, 10, 8)
+	if err != nil {
+		return unsetValue, err
+	}
+	if n > uint64(limit) {
+		return unsetValue, fmt.Errorf("value out of range: %d", n)
+	}
+	return int(n), nil
+}
+
+// String returns the tracestate value encoding of ots.
+func (ots otelTraceState) String() string {
+	fields := make([]string, 0, 2+len(ots.rest))
+	if ots.p != unsetValue {
+		fields = append(fields, pValueKey+":"+strconv.Itoa(ots.p))
+	}
+	if ots.r != unsetValue {
+		fields = append(fields, rValueKey+":"+strconv.Itoa(ots.r))
+	}
+	fields = append(fields, ots.rest...)
+	return strings.Join(fields, ";")
+}
+
+// withOTelTraceState returns ts with the OpenTelemetry entry set to ots.
+func withOTelTraceState(ts trace.TraceState, ots otelTraceState) trace.TraceState {
+	value := ots.String()
+	if value == "" {
+		return ts.Delete(otelTraceStateKey)
+	}
+	updated, err := ts.Insert(otelTraceStateKey, value)
+	if err != nil {
+		otel.Handle(err)
+		return ts
+	}
+	return updated
+}
+
+type consistentProbabilitySampler struct {
+	// lowP and highP are the p-values of the powers of two probabilities
+	// adjacent to the sampled fraction. The lowP is chosen with the lowProb
+	// probability so on average the fraction is sampled.
+	lowP, highP int
+	lowProb     float64
+	description string
+
+	mu  sync.Mutex
+	rnd *rand.Rand
+}
+
+func (cs *consistentProbabilitySampler) ShouldSample(p SamplingParameters) SamplingResult {
+	psc := trace.SpanContextFromContext(p.ParentContext)
+	ts := psc.TraceState()
+	ots, err := parseOTelTraceState(ts.Get(otelTraceStateKey))
+	if err != nil {
+		otel.Handle(err)
+	}
+
+	cs.mu.Lock()
+	if ots.r == unsetValue {
+		// The r-value is the number of leading zeros of a random number,
+		// i.e. r is at least n with a probability of 2^-n.
+		ots.r = bits.LeadingZeros64(cs.rnd.Uint64())
+		if ots.r > maxRValue {
+			ots.r = maxRValue
+		}
+	}
+	pValue := cs.highP
+	if cs.rnd.Float64() < cs.lowProb {
+		pValue = cs.lowP
+	}
+	cs.mu.Unlock()
+
+	decision := Drop
+	ots.p = unsetValue
+	if pValue <= ots.r {
+		decision = RecordAndSample
+		ots.p = pValue
+	}
+	return SamplingResult{
+		Decision:   decision,
+		Tracestate: withOTelTraceState(ts, ots),
+	}
+}
+
+func (cs *consistentProbabilitySampler) Description() string {
+	return cs.description
+}
+
+// ConsistentProbabilityBased samples a given fraction of traces consistently
+// across services. Unlike TraceIDRatioBased, the decision is based on the
+// r-value in the OpenTelemetry "ot" entry of the trace state. It is generated
+// when it is missing and propagated with the trace, so each service can
+// sample with a different fraction while the sampled traces stay complete
+// for the smallest one.
+//
+// The probability a span was sampled with is recorded as the p-value of the
+// trace state, the span represents 2^p spans. Because p-values encode powers
+// of two probabilities, a fraction that is not a power of two is sampled by
+// choosing between the two adjacent powers of two. Fractions >= 1 will always
+// sample. Fractions below 2^-62 are treated as zero. To respect the parent
+// trace's `SampledFlag`, the `ConsistentProbabilityBased` sampler should be
+// used as the root of a `ConsistentParentProbabilityBased` sampler.
+func ConsistentProbabilityBased(fraction float64) Sampler {
+	if fraction > 1 {
+		fraction = 1
+	}
+	if !(fraction >= minConsistentFraction) {
+		fraction = 0
+	}
+
+	var rngSeed int64
+	_ = binary.Read(crand.Reader, binary.LittleEndian, &rngSeed)
+	cs := &consistentProbabilitySampler{
+		description: fmt.Sprintf("ConsistentProbabilityBased{%g}", fraction),
+		rnd:         rand.New(rand.NewSource(rngSeed)),
+	}
+
+	if fraction == 0 {
+		cs.lowP, cs.highP = maxPValue, maxPValue
+		return cs
+	}
+
+	// fraction = frac * 2^exp, with frac in [0.5, 1).
+	frac, exp := math.Frexp(fraction)
+	cs.lowP, cs.highP = -exp, 1-exp
+	// 2^-lowP * lowProb + 2^-highP * (1 - lowProb) = fraction
+	cs.lowProb = 2*frac - 1
+	return cs
+}
+
+// ConsistentParentProbabilityBased returns a composite sampler which behaves
+// like ParentBased, but additionally checks the p-value of the parent trace
+// state is consistent with its `SampledFlag` before propagating it. An
+// inconsistent p-value is removed from the trace state and reported to the
+// OpenTelemetry error handler, so the sampling probability of spans is never
+// misrepresented. The root sampler is typically a ConsistentProbabilityBased
+// sampler.
+func ConsistentParentProbabilityBased(root Sampler, samplers ...ParentBasedSamplerOption) Sampler {
+	return consistentParentSampler{delegate: ParentBased(root, samplers...)}
+}
+
+type consistentParentSampler struct {
+	delegate Sampler
+}
+
+func (cs consistentParentSampler) ShouldSample(p SamplingParameters) SamplingResult {
+	psc := trace.SpanContextFromContext(p.ParentContext)
+	if !psc.IsValid() {
+		return cs.delegate.ShouldSample(p)
+	}
+
+	ts := psc.TraceState()
+	value := ts.Get(otelTraceStateKey)
+	if value == "" {
+		return cs.delegate.ShouldSample(p)
+	}
+
+	ots, err := parseOTelTraceState(value)
+	if err != nil {
+		otel.Handle(err)
+	}
+	if ots.p != unsetValue && (!psc.IsSampled() || (ots.r != unsetValue && ots.p > ots.r)) {
+		otel.Handle(fmt.Errorf("%w: %s (sampled: %t)", errInconsistentPValue, value, psc.IsSampled()))
+		ots.p = unsetValue
+	}
+
+	if updated := ots.String(); updated != value {
+		psc = psc.WithTraceState(withOTelTraceState(ts, ots))
+		p.ParentContext = trace.ContextWithSpanContext(p.ParentContext, psc)
+	}
+	return cs.delegate.ShouldSample(p)
+}
+
+func (cs consistentParentSampler) Description() string {
+	return fmt.Sprintf("ConsistentParentProbabilityBased{%s}", cs.delegate.Description())
+}
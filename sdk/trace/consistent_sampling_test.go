@@ -0,0 +1,245 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trace
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestParseOTelTraceState(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    otelTraceState
+		wantErr bool
+		encoded string
+	}{
+		{value: "", want: otelTraceState{p: unsetValue, r: unsetValue}},
+		{value: "p:2;r:5", want: otelTraceState{p: 2, r: 5}, encoded: "p:2;r:5"},
+		{value: "r:5;p:2", want: otelTraceState{p: 2, r: 5}, encoded: "p:2;r:5"},
+		{value: "r:62", want: otelTraceState{p: unsetValue, r: 62}, encoded: "r:62"},
+		{value: "p:63", want: otelTraceState{p: 63, r: unsetValue}, encoded: "p:63"},
+		{
+			value:   "x:y;p:0;r:0;z:1",
+			want:    otelTraceState{p: 0, r: 0, rest: []string{"x:y", "z:1"}},
+			encoded: "p:0;r:0;x:y;z:1",
+		},
+		{value: "p:64;r:5", want: otelTraceState{p: unsetValue, r: 5}, wantErr: true, encoded: "r:5"},
+		{value: "p:2;r:63", want: otelTraceState{p: 2, r: unsetValue}, wantErr: true, encoded: "p:2"},
+		{value: "p:-1", want: otelTraceState{p: unsetValue, r: unsetValue}, wantErr: true},
+		{value: "r:x", want: otelTraceState{p: unsetValue, r: unsetValue}, wantErr: true},
+		{value: "junk;r:1", want: otelTraceState{p: unsetValue, r: 1}, wantErr: true, encoded: "r:1"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.value, func(t *testing.T) {
+			got, err := parseOTelTraceState(test.value)
+			if test.wantErr {
+				assert.ErrorIs(t, err, errInvalidOTelTraceState)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, test.want, got)
+			assert.Equal(t, test.encoded, got.String())
+		})
+	}
+}
+
+func TestConsistentProbabilityBasedPValues(t *testing.T) {
+	tests := []struct {
+		fraction       float64
+		description    string
+		lowP, highP    int
+		lowProbability float64
+	}{
+		{fraction: 2, description: "ConsistentProbabilityBased{1}", lowP: -1, highP: 0},
+		{fraction: 1, description: "ConsistentProbabilityBased{1}", lowP: -1, highP: 0},
+		{fraction: 0.5, description: "ConsistentProbabilityBased{0.5}", lowP: 0, highP: 1},
+		{fraction: 0.25, description: "ConsistentProbabilityBased{0.25}", lowP: 1, highP: 2},
+		{fraction: 0.75, description: "ConsistentProbabilityBased{0.75}", lowP: 0, highP: 1, lowProbability: 0.5},
+		{fraction: 0.3, description: "ConsistentProbabilityBased{0.3}", lowP: 1, highP: 2, lowProbability: 0.2},
+		{fraction: 0x1p-62, description: "ConsistentProbabilityBased{2.168404344971009e-19}", lowP: 61, highP: 62},
+		{fraction: 0x1p-63, description: "ConsistentProbabilityBased{0}", lowP: 63, highP: 63},
+		{fraction: 0, description: "ConsistentProbabilityBased{0}", lowP: 63, highP: 63},
+		{fraction: -1, description: "ConsistentProbabilityBased{0}", lowP: 63, highP: 63},
+	}
+
+	for _, test := range tests {
+		s := ConsistentProbabilityBased(test.fraction).(*consistentProbabilitySampler)
+		assert.Equal(t, test.description, s.Description())
+		assert.Equal(t, test.lowP, s.lowP, "fraction %g", test.fraction)
+		assert.Equal(t, test.highP, s.highP, "fraction %g", test.fraction)
+		assert.InDelta(t, test.lowProbability, s.lowProb, 1e-9, "fraction %g", test.fraction)
+	}
+}
+
+func otelTraceStateContext(t *testing.T, tracestate string, flags trace.TraceFlags) context.Context {
+	t.Helper()
+
+	ts, err := trace.ParseTraceState(tracestate)
+	require.NoError(t, err)
+	return trace.ContextWithRemoteSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    tid,
+		SpanID:     sid,
+		TraceFlags: flags,
+		TraceState: ts,
+	}))
+}
+
+func TestConsistentProbabilityBasedUsesParentRValue(t *testing.T) {
+	ctx := otelTraceStateContext(t, "a=b,ot=r:3;x:y", 0)
+
+	res := ConsistentProbabilityBased(0.125).ShouldSample(SamplingParameters{ParentContext: ctx})
+	assert.Equal(t, RecordAndSample, res.Decision)
+	assert.Equal(t, "ot=p:3;r:3;x:y,a=b", res.Tracestate.String())
+
+	res = ConsistentProbabilityBased(0.0625).ShouldSample(SamplingParameters{ParentContext: ctx})
+	assert.Equal(t, Drop, res.Decision)
+	assert.Equal(t, "ot=r:3;x:y,a=b", res.Tracestate.String())
+}
+
+func TestConsistentProbabilityBasedGeneratesRValue(t *testing.T) {
+	const n = 20000
+
+	for _, fraction := range []float64{0, 0.01, 0.25, 0.3, 0.75, 1} {
+		s := ConsistentProbabilityBased(fraction).(*consistentProbabilitySampler)
+		s.rnd = rand.New(rand.NewSource(1))
+
+		var sampled int
+		for i := 0; i < n; i++ {
+			res := s.ShouldSample(SamplingParameters{ParentContext: context.Background()})
+			ots, err := parseOTelTraceState(res.Tracestate.Get(otelTraceStateKey))
+			require.NoError(t, err)
+			require.NotEqual(t, unsetValue, ots.r, "r-value not generated")
+
+			if res.Decision == RecordAndSample {
+				sampled++
+				require.LessOrEqual(t, ots.p, ots.r)
+			} else {
+				require.Equal(t, unsetValue, ots.p, "p-value of dropped span")
+			}
+		}
+		assert.InDelta(t, fraction, float64(sampled)/n, 0.01, "fraction %g", fraction)
+	}
+}
+
+func TestConsistentProbabilityBasedInvalidTraceState(t *testing.T) {
+	handler.Reset()
+	t.Cleanup(handler.Reset)
+
+	ctx := otelTraceStateContext(t, "ot=p:99;r:2", trace.FlagsSampled)
+	res := ConsistentProbabilityBased(0.25).ShouldSample(SamplingParameters{ParentContext: ctx})
+	assert.Equal(t, RecordAndSample, res.Decision)
+	assert.Equal(t, "ot=p:2;r:2", res.Tracestate.String())
+	testStoredError(t, errInvalidOTelTraceState)
+}
+
+func TestConsistentParentProbabilityBased(t *testing.T) {
+	tests := []struct {
+		name       string
+		tracestate string
+		flags      trace.TraceFlags
+		decision   SamplingDecision
+		want       string
+		err        error
+	}{
+		{
+			name:       "sampled",
+			tracestate: "ot=p:2;r:5,a=b",
+			flags:      trace.FlagsSampled,
+			decision:   RecordAndSample,
+			want:       "ot=p:2;r:5,a=b",
+		},
+		{
+			name:       "not sampled",
+			tracestate: "ot=r:5,a=b",
+			decision:   Drop,
+			want:       "ot=r:5,a=b",
+		},
+		{
+			name:       "no OpenTelemetry values",
+			tracestate: "a=b",
+			flags:      trace.FlagsSampled,
+			decision:   RecordAndSample,
+			want:       "a=b",
+		},
+		{
+			name:       "p-value of unsampled parent",
+			tracestate: "a=b,ot=p:2;r:5",
+			decision:   Drop,
+			want:       "ot=r:5,a=b",
+			err:        errInconsistentPValue,
+		},
+		{
+			name:       "p-value greater than r-value",
+			tracestate: "ot=p:6;r:5",
+			flags:      trace.FlagsSampled,
+			decision:   RecordAndSample,
+			want:       "ot=r:5",
+			err:        errInconsistentPValue,
+		},
+		{
+			name:       "only p-value",
+			tracestate: "ot=p:2",
+			decision:   Drop,
+			want:       "",
+			err:        errInconsistentPValue,
+		},
+		{
+			name:       "invalid",
+			tracestate: "ot=p:2;r:99",
+			flags:      trace.FlagsSampled,
+			decision:   RecordAndSample,
+			want:       "ot=p:2",
+			err:        errInvalidOTelTraceState,
+		},
+	}
+
+	sampler := ConsistentParentProbabilityBased(ConsistentProbabilityBased(0.25))
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			handler.Reset()
+			t.Cleanup(handler.Reset)
+
+			ctx := otelTraceStateContext(t, test.tracestate, test.flags)
+			res := sampler.ShouldSample(SamplingParameters{ParentContext: ctx, TraceID: tid})
+			assert.Equal(t, test.decision, res.Decision)
+			assert.Equal(t, test.want, res.Tracestate.String())
+			if test.err != nil {
+				testStoredError(t, test.err)
+			} else {
+				assert.Empty(t, handler.errs)
+			}
+		})
+	}
+}
+
+func TestConsistentParentProbabilityBasedRoot(t *testing.T) {
+	sampler := ConsistentParentProbabilityBased(ConsistentProbabilityBased(1))
+	assert.Equal(t, "ConsistentParentProbabilityBased{"+ParentBased(ConsistentProbabilityBased(1)).Description()+"}", sampler.Description())
+
+	res := sampler.ShouldSample(SamplingParameters{ParentContext: context.Background()})
+	assert.Equal(t, RecordAndSample, res.Decision)
+	ots, err := parseOTelTraceState(res.Tracestate.Get(otelTraceStateKey))
+	require.NoError(t, err)
+	assert.Equal(t, 0, ots.p)
+	assert.NotEqual(t, unsetValue, ots.r)
+}
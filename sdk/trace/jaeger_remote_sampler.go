@@ -0,0 +1,430 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trace // import "go.opentelemetry.io/otel/sdk/trace"
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/otel"
+)
+
+const (
+	defaultSamplingServerURL       = "http://localhost:5778/sampling"
+	defaultSamplingRefreshInterval = time.Minute
+	defaultInitialSamplingRate     = 0.001
+
+	// maxSamplingStrategySize is the maximum size of a sampling strategy
+	// response read from the sampling server.
+	maxSamplingStrategySize = 1 << 20
+)
+
+var (
+	errSamplingStrategyUpdate = errors.New("failed to update jaeger remote sampling strategy")
+	errSamplingStrategyType   = errors.New("unsupported sampling strategy type")
+)
+
+// jaegerRemoteConfig is the configuration of a JaegerRemoteSampler.
+type jaegerRemoteConfig struct {
+	samplingServerURL       string
+	samplingRefreshInterval time.Duration
+	initialSampler          Sampler
+}
+
+// JaegerRemoteSamplerOption configures a JaegerRemoteSampler.
+type JaegerRemoteSamplerOption interface {
+	apply(jaegerRemoteConfig) jaegerRemoteConfig
+}
+
+type jaegerRemoteOptionFunc func(jaegerRemoteConfig) jaegerRemoteConfig
+
+func (fn jaegerRemoteOptionFunc) apply(cfg jaegerRemoteConfig) jaegerRemoteConfig {
+	return fn(cfg)
+}
+
+// WithSamplingServerURL sets the URL of the Jaeger compatible sampling
+// endpoint the sampling strategies are fetched from. The service name is
+// added to it as the "service" query parameter.
+//
+// By default, http://localhost:5778/sampling is used.
+func WithSamplingServerURL(u string) JaegerRemoteSamplerOption {
+	return jaegerRemoteOptionFunc(func(cfg jaegerRemoteConfig) jaegerRemoteConfig {
+		cfg.samplingServerURL = u
+		return cfg
+	})
+}
+
+// WithSamplingRefreshInterval sets the interval the sampling strategies are
+// fetched at. Non-positive intervals are ignored.
+//
+// By default, the sampling strategies are fetched every minute.
+func WithSamplingRefreshInterval(d time.Duration) JaegerRemoteSamplerOption {
+	return jaegerRemoteOptionFunc(func(cfg jaegerRemoteConfig) jaegerRemoteConfig {
+		if d > 0 {
+			cfg.samplingRefreshInterval = d
+		}
+		return cfg
+	})
+}
+
+// WithInitialSampler sets the Sampler used until a sampling strategy has
+// been fetched from the sampling endpoint. A nil Sampler is ignored.
+//
+// By default, TraceIDRatioBased(0.001) is used.
+func WithInitialSampler(s Sampler) JaegerRemoteSamplerOption {
+	return jaegerRemoteOptionFunc(func(cfg jaegerRemoteConfig) jaegerRemoteConfig {
+		if s != nil {
+			cfg.initialSampler = s
+		}
+		return cfg
+	})
+}
+
+// JaegerRemoteSampler is a Sampler that applies the sampling strategies of a
+// service fetched periodically from a Jaeger compatible sampling endpoint.
+//
+// Probabilistic strategies are applied with a TraceIDRatioBased Sampler,
+// rate limiting strategies with a RateLimited Sampler, and per-operation
+// strategies select a strategy based on the name of the span. The
+// probabilistic strategy of an operation is combined with the lower bound
+// rate limit of the per-operation strategies, so every operation is sampled
+// at least at that rate. Operations without a strategy of their own share
+// the default strategy.
+//
+// The initial Sampler is used until a strategy has been fetched. If the
+// sampling endpoint is unreachable, or it returns an invalid strategy, the
+// error is sent to the OpenTelemetry error handler and the Sampler in use is
+// kept.
+//
+// Fetching the sampling strategies starts when the first span is sampled and
+// continues until Close is called. To respect the parent trace's
+// `SampledFlag`, the JaegerRemoteSampler should be used as a delegate of a
+// `Parent` sampler.
+type JaegerRemoteSampler struct {
+	serviceName string
+	// serviceFromResource is true if serviceName is resolved from the
+	// Resource of the TracerProvider.
+	serviceFromResource bool
+	conf                jaegerRemoteConfig
+	client              *http.Client
+
+	// sampler holds the samplerHolder of the Sampler currently in use.
+	sampler atomic.Value
+	// lastStrategy is the last strategy response applied. It is only
+	// accessed by the polling goroutine.
+	lastStrategy []byte
+
+	startOnce sync.Once
+	stopOnce  sync.Once
+	stop      chan struct{}
+	done      chan struct{}
+}
+
+// samplerHolder wraps a Sampler so the dynamic types stored in an
+// atomic.Value are consistent.
+type samplerHolder struct {
+	Sampler
+}
+
+var _ Sampler = (*JaegerRemoteSampler)(nil)
+
+// NewJaegerRemoteSampler returns a JaegerRemoteSampler that applies the
+// sampling strategies of the serviceName service.
+func NewJaegerRemoteSampler(serviceName string, opts ...JaegerRemoteSamplerOption) *JaegerRemoteSampler {
+	cfg := jaegerRemoteConfig{
+		samplingServerURL:       defaultSamplingServerURL,
+		samplingRefreshInterval: defaultSamplingRefreshInterval,
+		initialSampler:          TraceIDRatioBased(defaultInitialSamplingRate),
+	}
+	for _, opt := range opts {
+		cfg = opt.apply(cfg)
+	}
+
+	s := &JaegerRemoteSampler{
+		serviceName: serviceName,
+		conf:        cfg,
+		client:      &http.Client{Timeout: cfg.samplingRefreshInterval},
+		stop:        make(chan struct{}),
+		done:        make(chan struct{}),
+	}
+	s.sampler.Store(samplerHolder{cfg.initialSampler})
+	return s
+}
+
+// ShouldSample returns the sampling decision of the Sampler applying the
+// current sampling strategy.
+func (s *JaegerRemoteSampler) ShouldSample(p SamplingParameters) SamplingResult {
+	s.startOnce.Do(func() { go s.poll() })
+	return s.current().ShouldSample(p)
+}
+
+// Description returns information describing the Sampler.
+func (s *JaegerRemoteSampler) Description() string {
+	return fmt.Sprintf("JaegerRemoteSampler{%s}", s.current().Description())
+}
+
+// Close stops fetching sampling strategies. The Sampler in use when Close is
+// called keeps being applied.
+//
+// A TracerProvider closes its JaegerRemoteSampler when it is shut down.
+func (s *JaegerRemoteSampler) Close() {
+	s.stopOnce.Do(func() {
+		close(s.stop)
+		// Ensure polling is never started after Close.
+		s.startOnce.Do(func() { close(s.done) })
+		<-s.done
+		s.client.CloseIdleConnections()
+	})
+}
+
+func (s *JaegerRemoteSampler) current() Sampler {
+	return s.sampler.Load().(samplerHolder).Sampler
+}
+
+// poll updates the sampling strategy until the sampler is closed.
+func (s *JaegerRemoteSampler) poll() {
+	defer close(s.done)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-s.stop:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	ticker := time.NewTicker(s.conf.samplingRefreshInterval)
+	defer ticker.Stop()
+	for {
+		if err := s.update(ctx); err != nil && ctx.Err() == nil {
+			otel.Handle(fmt.Errorf("%w: %v", errSamplingStrategyUpdate, err))
+		}
+
+		select {
+		case <-s.stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// update fetches the sampling strategy and applies it if it changed.
+func (s *JaegerRemoteSampler) update(ctx context.Context) error {
+	strategy, err := s.fetch(ctx)
+	if err != nil {
+		return err
+	}
+	if s.lastStrategy != nil && bytes.Equal(strategy, s.lastStrategy) {
+		// Keep the current sampler so rate limits are not reset.
+		return nil
+	}
+
+	var resp samplingStrategyResponse
+	if err := json.Unmarshal(strategy, &resp); err != nil {
+		return err
+	}
+	sampler, err := resp.sampler()
+	if err != nil {
+		return err
+	}
+
+	s.sampler.Store(samplerHolder{sampler})
+	s.lastStrategy = strategy
+	return nil
+}
+
+// fetch returns the sampling strategy response from the sampling endpoint.
+func (s *JaegerRemoteSampler) fetch(ctx context.Context) ([]byte, error) {
+	u, err := url.Parse(s.conf.samplingServerURL)
+	if err != nil {
+		return nil, err
+	}
+	q := u.Query()
+	q.Set("service", s.serviceName)
+	u.RawQuery = q.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status from %s: %s", s.conf.samplingServerURL, resp.Status)
+	}
+	return io.ReadAll(io.LimitReader(resp.Body, maxSamplingStrategySize))
+}
+
+// samplingStrategyType is the type of a Jaeger sampling strategy. It is
+// encoded either by name or by its value in the Jaeger API.
+type samplingStrategyType int
+
+const (
+	probabilisticStrategy samplingStrategyType = iota
+	rateLimitingStrategy
+)
+
+func (t *samplingStrategyType) UnmarshalJSON(b []byte) error {
+	var name string
+	if err := json.Unmarshal(b, &name); err != nil {
+		var v int
+		if err := json.Unmarshal(b, &v); err != nil {
+			return fmt.Errorf("%w: %s", errSamplingStrategyType, b)
+		}
+		*t = samplingStrategyType(v)
+		return nil
+	}
+
+	switch strings.ToUpper(name) {
+	case "PROBABILISTIC":
+		*t = probabilisticStrategy
+	case "RATE_LIMITING":
+		*t = rateLimitingStrategy
+	default:
+		return fmt.Errorf("%w: %s", errSamplingStrategyType, name)
+	}
+	return nil
+}
+
+type probabilisticSamplingStrategy struct {
+	SamplingRate float64 `json:"samplingRate"`
+}
+
+type rateLimitingSamplingStrategy struct {
+	MaxTracesPerSecond float64 `json:"maxTracesPerSecond"`
+}
+
+type operationSamplingStrategy struct {
+	Operation             string                         `json:"operation"`
+	ProbabilisticSampling *probabilisticSamplingStrategy `json:"probabilisticSampling"`
+}
+
+type perOperationSamplingStrategies struct {
+	DefaultSamplingProbability       float64                     `json:"defaultSamplingProbability"`
+	DefaultLowerBoundTracesPerSecond float64                     `json:"defaultLowerBoundTracesPerSecond"`
+	PerOperationStrategies           []operationSamplingStrategy `json:"perOperationStrategies"`
+}
+
+// samplingStrategyResponse is the sampling strategy response of a Jaeger
+// compatible sampling endpoint.
+type samplingStrategyResponse struct {
+	StrategyType          samplingStrategyType            `json:"strategyType"`
+	ProbabilisticSampling *probabilisticSamplingStrategy  `json:"probabilisticSampling"`
+	RateLimitingSampling  *rateLimitingSamplingStrategy   `json:"rateLimitingSampling"`
+	OperationSampling     *perOperationSamplingStrategies `json:"operationSampling"`
+}
+
+// sampler returns the Sampler that applies the strategy of r.
+func (r samplingStrategyResponse) sampler() (Sampler, error) {
+	if r.OperationSampling != nil {
+		return r.OperationSampling.sampler(), nil
+	}
+
+	switch r.StrategyType {
+	case probabilisticStrategy:
+		if r.ProbabilisticSampling != nil {
+			return TraceIDRatioBased(r.ProbabilisticSampling.SamplingRate), nil
+		}
+	case rateLimitingStrategy:
+		if r.RateLimitingSampling != nil {
+			return RateLimited(r.RateLimitingSampling.MaxTracesPerSecond), nil
+		}
+	default:
+		return nil, fmt.Errorf("%w: %d", errSamplingStrategyType, r.StrategyType)
+	}
+	return nil, fmt.Errorf("missing sampling strategy parameters: %+v", r)
+}
+
+func (s perOperationSamplingStrategies) sampler() Sampler {
+	lowerBound := s.DefaultLowerBoundTracesPerSecond
+	ops := make(map[string]Sampler, len(s.PerOperationStrategies))
+	for _, op := range s.PerOperationStrategies {
+		rate := s.DefaultSamplingProbability
+		if op.ProbabilisticSampling != nil {
+			rate = op.ProbabilisticSampling.SamplingRate
+		}
+		ops[op.Operation] = guaranteedThroughput(rate, lowerBound)
+	}
+	return &perOperationSampler{
+		operations:     ops,
+		defaultSampler: guaranteedThroughput(s.DefaultSamplingProbability, lowerBound),
+	}
+}
+
+// perOperationSampler samples spans with the Sampler of their operation, the
+// span name, or a default Sampler if the operation has none.
+type perOperationSampler struct {
+	operations     map[string]Sampler
+	defaultSampler Sampler
+}
+
+func (s *perOperationSampler) ShouldSample(p SamplingParameters) SamplingResult {
+	if sampler, ok := s.operations[p.Name]; ok {
+		return sampler.ShouldSample(p)
+	}
+	return s.defaultSampler.ShouldSample(p)
+}
+
+func (s *perOperationSampler) Description() string {
+	return fmt.Sprintf("PerOperationSampler{default:%s,operations:%d}", s.defaultSampler.Description(), len(s.operations))
+}
+
+// guaranteedThroughput returns a Sampler that samples the fraction of traces
+// and at least lowerBound traces per second.
+func guaranteedThroughput(fraction, lowerBound float64) Sampler {
+	if lowerBound <= 0 {
+		return TraceIDRatioBased(fraction)
+	}
+	return guaranteedThroughputSampler{
+		probabilistic: TraceIDRatioBased(fraction),
+		lowerBound:    RateLimited(lowerBound),
+	}
+}
+
+type guaranteedThroughputSampler struct {
+	probabilistic Sampler
+	lowerBound    Sampler
+}
+
+func (s guaranteedThroughputSampler) ShouldSample(p SamplingParameters) SamplingResult {
+	// Always consult the lower bound so traces sampled probabilistically
+	// count towards it.
+	result := s.probabilistic.ShouldSample(p)
+	lowerBound := s.lowerBound.ShouldSample(p)
+	if result.Decision == RecordAndSample {
+		return result
+	}
+	return lowerBound
+}
+
+func (s guaranteedThroughputSampler) Description() string {
+	return fmt.Sprintf("GuaranteedThroughput{probabilistic:%s,lowerBound:%s}", s.probabilistic.Description(), s.lowerBound.Description())
+}
@@ -0,0 +1,380 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trace
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel"
+	ottest "go.opentelemetry.io/otel/internal/internaltest"
+	"go.opentelemetry.io/otel/sdk/resource"
+	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
+)
+
+const (
+	probabilisticResponse = `{"strategyType":"PROBABILISTIC","probabilisticSampling":{"samplingRate":0.5}}`
+	rateLimitingResponse  = `{"strategyType":"RATE_LIMITING","rateLimitingSampling":{"maxTracesPerSecond":2}}`
+)
+
+// strategyServer is a Jaeger compatible sampling endpoint.
+type strategyServer struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	status   int
+	response string
+	services []string
+}
+
+func newStrategyServer(t *testing.T, response string) *strategyServer {
+	t.Helper()
+
+	s := &strategyServer{status: http.StatusOK, response: response}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.services = append(s.services, r.URL.Query().Get("service"))
+		w.WriteHeader(s.status)
+		_, _ = w.Write([]byte(s.response))
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *strategyServer) set(status int, response string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.status, s.response = status, response
+}
+
+func (s *strategyServer) requests() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.services...)
+}
+
+// syncErrorHandler is an ErrorHandler safe to use by the polling goroutine
+// of a JaegerRemoteSampler.
+type syncErrorHandler struct {
+	mu   sync.Mutex
+	errs []error
+}
+
+func (h *syncErrorHandler) Handle(err error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.errs = append(h.errs, err)
+}
+
+func (h *syncErrorHandler) len() int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return len(h.errs)
+}
+
+// errorHandler replaces the global ErrorHandler for the duration of the
+// test. Samplers need to be closed before the test completes.
+func errorHandler(t *testing.T) *syncErrorHandler {
+	h := &syncErrorHandler{}
+	otel.SetErrorHandler(h)
+	t.Cleanup(func() { otel.SetErrorHandler(handler) })
+	return h
+}
+
+func newTestJaegerRemoteSampler(t *testing.T, u string, opts ...JaegerRemoteSamplerOption) *JaegerRemoteSampler {
+	t.Helper()
+
+	opts = append([]JaegerRemoteSamplerOption{
+		WithSamplingServerURL(u),
+		WithSamplingRefreshInterval(10 * time.Millisecond),
+		WithInitialSampler(NeverSample()),
+	}, opts...)
+	return NewJaegerRemoteSampler("test-service", opts...)
+}
+
+// eventuallyDescribed starts the polling of s and waits for it to apply the
+// Sampler with the description.
+func eventuallyDescribed(t *testing.T, s *JaegerRemoteSampler, description string) {
+	t.Helper()
+
+	s.ShouldSample(SamplingParameters{ParentContext: context.Background()})
+	want := "JaegerRemoteSampler{" + description + "}"
+	require.Eventually(t, func() bool {
+		return s.Description() == want
+	}, time.Second, 5*time.Millisecond, "want %s, got %s", want, s.Description())
+}
+
+func TestJaegerRemoteSamplerDefaults(t *testing.T) {
+	s := NewJaegerRemoteSampler("test-service")
+	defer s.Close()
+
+	assert.Equal(t, defaultSamplingServerURL, s.conf.samplingServerURL)
+	assert.Equal(t, defaultSamplingRefreshInterval, s.conf.samplingRefreshInterval)
+	assert.Equal(t, "JaegerRemoteSampler{TraceIDRatioBased{0.001}}", s.Description())
+}
+
+func TestJaegerRemoteSamplerProbabilistic(t *testing.T) {
+	errs := errorHandler(t)
+	srv := newStrategyServer(t, probabilisticResponse)
+	s := newTestJaegerRemoteSampler(t, srv.URL)
+	defer s.Close()
+
+	assert.Equal(t, "JaegerRemoteSampler{AlwaysOffSampler}", s.Description(), "initial sampler")
+	eventuallyDescribed(t, s, "TraceIDRatioBased{0.5}")
+	assert.Equal(t, "test-service", srv.requests()[0])
+
+	s.Close()
+	assert.Equal(t, 0, errs.len())
+}
+
+func TestJaegerRemoteSamplerRateLimiting(t *testing.T) {
+	errorHandler(t)
+	srv := newStrategyServer(t, rateLimitingResponse)
+	s := newTestJaegerRemoteSampler(t, srv.URL)
+	defer s.Close()
+
+	eventuallyDescribed(t, s, "RateLimited{2}")
+
+	// The Jaeger API may encode the strategy type by value.
+	srv.set(http.StatusOK, `{"strategyType":1,"rateLimitingSampling":{"maxTracesPerSecond":3}}`)
+	eventuallyDescribed(t, s, "RateLimited{3}")
+}
+
+func TestJaegerRemoteSamplerKeepsUnchangedStrategy(t *testing.T) {
+	errorHandler(t)
+	srv := newStrategyServer(t, rateLimitingResponse)
+	s := newTestJaegerRemoteSampler(t, srv.URL)
+	defer s.Close()
+
+	eventuallyDescribed(t, s, "RateLimited{2}")
+	applied := s.current()
+
+	n := len(srv.requests())
+	require.Eventually(t, func() bool {
+		return len(srv.requests()) > n
+	}, time.Second, 5*time.Millisecond)
+	assert.Same(t, applied, s.current(), "unchanged strategy replaced the rate limiter")
+}
+
+func TestJaegerRemoteSamplerPerOperation(t *testing.T) {
+	errorHandler(t)
+	srv := newStrategyServer(t, `{
+		"strategyType": "PROBABILISTIC",
+		"operationSampling": {
+			"defaultSamplingProbability": 0,
+			"defaultLowerBoundTracesPerSecond": 0,
+			"perOperationStrategies": [
+				{"operation": "sampled", "probabilisticSampling": {"samplingRate": 1}},
+				{"operation": "dropped", "probabilisticSampling": {"samplingRate": 0}}
+			]
+		}
+	}`)
+	s := newTestJaegerRemoteSampler(t, srv.URL)
+	defer s.Close()
+
+	eventuallyDescribed(t, s, "PerOperationSampler{default:TraceIDRatioBased{0},operations:2}")
+
+	for name, want := range map[string]SamplingDecision{
+		"sampled": RecordAndSample,
+		"dropped": Drop,
+		"unknown": Drop,
+	} {
+		p := SamplingParameters{ParentContext: context.Background(), Name: name}
+		assert.Equal(t, want, s.ShouldSample(p).Decision, name)
+	}
+}
+
+func TestJaegerRemoteSamplerPerOperationLowerBound(t *testing.T) {
+	errorHandler(t)
+	srv := newStrategyServer(t, `{
+		"operationSampling": {
+			"defaultSamplingProbability": 0,
+			"defaultLowerBoundTracesPerSecond": 1
+		}
+	}`)
+	s := newTestJaegerRemoteSampler(t, srv.URL, WithSamplingRefreshInterval(time.Hour))
+	defer s.Close()
+
+	eventuallyDescribed(t, s, "PerOperationSampler{default:GuaranteedThroughput{probabilistic:TraceIDRatioBased{0},lowerBound:RateLimited{1}},operations:0}")
+
+	var sampled int
+	for i := 0; i < 10; i++ {
+		p := SamplingParameters{ParentContext: context.Background(), Name: "op"}
+		if s.ShouldSample(p).Decision == RecordAndSample {
+			sampled++
+		}
+	}
+	assert.Equal(t, 1, sampled, "lower bound not applied")
+}
+
+func TestJaegerRemoteSamplerUnreachable(t *testing.T) {
+	errs := errorHandler(t)
+	srv := newStrategyServer(t, probabilisticResponse)
+	srv.Close()
+
+	s := newTestJaegerRemoteSampler(t, srv.URL)
+	defer s.Close()
+
+	s.ShouldSample(SamplingParameters{ParentContext: context.Background()})
+	require.Eventually(t, func() bool {
+		return errs.len() > 0
+	}, time.Second, 5*time.Millisecond)
+	assert.Equal(t, "JaegerRemoteSampler{AlwaysOffSampler}", s.Description(), "initial sampler not used")
+
+	s.Close()
+	errs.mu.Lock()
+	defer errs.mu.Unlock()
+	assert.ErrorIs(t, errs.errs[0], errSamplingStrategyUpdate)
+}
+
+func TestJaegerRemoteSamplerInvalidStrategy(t *testing.T) {
+	errs := errorHandler(t)
+	srv := newStrategyServer(t, probabilisticResponse)
+	s := newTestJaegerRemoteSampler(t, srv.URL)
+	defer s.Close()
+
+	eventuallyDescribed(t, s, "TraceIDRatioBased{0.5}")
+
+	for _, resp := range []struct {
+		status int
+		body   string
+	}{
+		{http.StatusInternalServerError, rateLimitingResponse},
+		{http.StatusOK, "not json"},
+		{http.StatusOK, `{"strategyType":"UNKNOWN"}`},
+		{http.StatusOK, `{"strategyType":"RATE_LIMITING"}`},
+	} {
+		n := errs.len()
+		srv.set(resp.status, resp.body)
+		require.Eventually(t, func() bool {
+			return errs.len() > n
+		}, time.Second, 5*time.Millisecond, "no error for %d: %s", resp.status, resp.body)
+		assert.Equal(t, "JaegerRemoteSampler{TraceIDRatioBased{0.5}}", s.Description(), "strategy replaced for %d: %s", resp.status, resp.body)
+	}
+}
+
+func TestJaegerRemoteSamplerClose(t *testing.T) {
+	errorHandler(t)
+	srv := newStrategyServer(t, probabilisticResponse)
+	s := newTestJaegerRemoteSampler(t, srv.URL)
+
+	eventuallyDescribed(t, s, "TraceIDRatioBased{0.5}")
+	s.Close()
+
+	n := len(srv.requests())
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, n, len(srv.requests()), "strategies fetched after Close")
+
+	// The last strategy is still applied and polling is not restarted.
+	s.ShouldSample(SamplingParameters{ParentContext: context.Background()})
+	assert.Equal(t, "JaegerRemoteSampler{TraceIDRatioBased{0.5}}", s.Description())
+	assert.NotPanics(t, s.Close)
+}
+
+func TestJaegerRemoteSamplerCloseNotStarted(t *testing.T) {
+	srv := newStrategyServer(t, probabilisticResponse)
+	s := newTestJaegerRemoteSampler(t, srv.URL)
+	s.Close()
+
+	s.ShouldSample(SamplingParameters{ParentContext: context.Background()})
+	time.Sleep(50 * time.Millisecond)
+	assert.Empty(t, srv.requests(), "polling started after Close")
+}
+
+func TestJaegerRemoteSamplerFromEnvArg(t *testing.T) {
+	s, err := jaegerRemoteFromEnv("endpoint=http://localhost:1234/sampling, pollingIntervalMs=5000,initialSamplingRate=0.25,unknown=1")
+	require.NoError(t, err)
+	remote := s.(*JaegerRemoteSampler)
+	assert.Equal(t, "http://localhost:1234/sampling", remote.conf.samplingServerURL)
+	assert.Equal(t, 5*time.Second, remote.conf.samplingRefreshInterval)
+	assert.Equal(t, "JaegerRemoteSampler{TraceIDRatioBased{0.25}}", remote.Description())
+
+	s, err = jaegerRemoteFromEnv("pollingIntervalMs=soon,initialSamplingRate=2")
+	assert.ErrorAs(t, err, new(samplerArgParseError))
+	remote = s.(*JaegerRemoteSampler)
+	assert.Equal(t, defaultSamplingRefreshInterval, remote.conf.samplingRefreshInterval)
+	assert.Equal(t, "JaegerRemoteSampler{TraceIDRatioBased{0.001}}", remote.Description())
+
+	_, err = jaegerRemoteFromEnv("initialSamplingRate=2")
+	assert.ErrorIs(t, err, errGreaterThanOneTraceIDRatio)
+}
+
+func TestTracerProviderShutdownClosesJaegerRemoteSampler(t *testing.T) {
+	for _, sampler := range []string{"jaeger_remote", "parentbased_jaeger_remote"} {
+		t.Run(sampler, func(t *testing.T) {
+			errorHandler(t)
+			before := runtime.NumGoroutine()
+
+			srv := newStrategyServer(t, probabilisticResponse)
+			envStore, err := ottest.SetEnvVariables(map[string]string{
+				"OTEL_TRACES_SAMPLER":     sampler,
+				"OTEL_TRACES_SAMPLER_ARG": "endpoint=" + srv.URL + ",pollingIntervalMs=10",
+			})
+			require.NoError(t, err)
+			t.Cleanup(func() { require.NoError(t, envStore.Restore()) })
+
+			tp := NewTracerProvider()
+			_, span := tp.Tracer("JaegerRemote").Start(context.Background(), "span")
+			span.End()
+			require.Eventually(t, func() bool {
+				return len(srv.requests()) > 0
+			}, time.Second, 5*time.Millisecond, "polling not started")
+
+			require.NoError(t, tp.Shutdown(context.Background()))
+			n := len(srv.requests())
+			srv.Close()
+
+			// Not using assert.Eventually, it runs the condition in its own
+			// goroutine.
+			for i := 0; i < 200 && runtime.NumGoroutine() > before; i++ {
+				time.Sleep(5 * time.Millisecond)
+			}
+			assert.LessOrEqual(t, runtime.NumGoroutine(), before, "goroutines leaked")
+			assert.Equal(t, n, len(srv.requests()), "strategies fetched after shutdown")
+		})
+	}
+}
+
+func TestJaegerRemoteSamplerFromEnvUsesResourceServiceName(t *testing.T) {
+	errorHandler(t)
+
+	srv := newStrategyServer(t, probabilisticResponse)
+	envStore, err := ottest.SetEnvVariables(map[string]string{
+		"OTEL_TRACES_SAMPLER":     "jaeger_remote",
+		"OTEL_TRACES_SAMPLER_ARG": "endpoint=" + srv.URL,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, envStore.Restore()) })
+
+	res := resource.NewSchemaless(semconv.ServiceNameKey.String("checkout"))
+	tp := NewTracerProvider(WithResource(res))
+	t.Cleanup(func() { require.NoError(t, tp.Shutdown(context.Background())) })
+
+	_, span := tp.Tracer("JaegerRemote").Start(context.Background(), "span")
+	span.End()
+	require.Eventually(t, func() bool {
+		return len(srv.requests()) > 0
+	}, time.Second, 5*time.Millisecond, "polling not started")
+	assert.Equal(t, "checkout", srv.requests()[0])
+}
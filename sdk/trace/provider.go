@@ -228,7 +228,11 @@ func (p *TracerProvider) ForceFlush(ctx context.Context) error {
 }
 
 // Shutdown shuts down the span processors in the order they were registered.
+// It also closes the Sampler of the TracerProvider, and the Samplers it
+// delegates to, if they have a Close method to release their resources.
 func (p *TracerProvider) Shutdown(ctx context.Context) error {
+	closeSampler(p.sampler)
+
 	spss := p.spanProcessors.Load().(spanProcessorStates)
 	if len(spss) == 0 {
 		return nil
@@ -258,6 +262,23 @@ func (p *TracerProvider) Shutdown(ctx context.Context) error {
 	return retErr
 }
 
+// closeSampler closes s and the Samplers it delegates to that have a Close
+// method, e.g. a JaegerRemoteSampler polling for sampling strategies.
+func closeSampler(s Sampler) {
+	switch s := s.(type) {
+	case parentBased:
+		closeSampler(s.root)
+		closeSampler(s.config.remoteParentSampled)
+		closeSampler(s.config.remoteParentNotSampled)
+		closeSampler(s.config.localParentSampled)
+		closeSampler(s.config.localParentNotSampled)
+	case consistentParentSampler:
+		closeSampler(s.delegate)
+	case interface{ Close() }:
+		s.Close()
+	}
+}
+
 // TracerProviderOption configures a TracerProvider.
 type TracerProviderOption interface {
 	apply(tracerProviderConfig) tracerProviderConfig
@@ -444,5 +465,6 @@ func ensureValidTracerProviderConfig(cfg tracerProviderConfig) tracerProviderCon
 	if cfg.resource == nil {
 		cfg.resource = resource.Default()
 	}
+	resolveJaegerRemoteService(cfg.sampler, cfg.resource)
 	return cfg
 }
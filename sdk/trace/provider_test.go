@@ -208,6 +208,68 @@ func TestTracerProviderSamplerConfigFromEnv(t *testing.T) {
 			description:         ParentBased(TraceIDRatioBased(1.0)).Description(),
 			invalidArgErrorType: new(samplerArgParseError),
 		},
+		{
+			sampler:     "ratelimited",
+			samplerArg:  "10",
+			description: RateLimited(10).Description(),
+		},
+		{
+			sampler:     "ratelimited",
+			samplerArg:  "-10",
+			description: RateLimited(1.0).Description(),
+			errorType:   errNegativeRateLimit,
+		},
+		{
+			sampler:             "ratelimited",
+			argOptional:         true,
+			description:         RateLimited(1.0).Description(),
+			invalidArgErrorType: new(samplerArgParseError),
+		},
+		{
+			sampler:     "parentbased_ratelimited",
+			samplerArg:  "10",
+			description: ParentBased(RateLimited(10)).Description(),
+		},
+		{
+			sampler:     "parentbased_ratelimited",
+			samplerArg:  "-10",
+			description: ParentBased(RateLimited(1.0)).Description(),
+			errorType:   errNegativeRateLimit,
+		},
+		{
+			sampler:             "parentbased_ratelimited",
+			argOptional:         true,
+			description:         ParentBased(RateLimited(1.0)).Description(),
+			invalidArgErrorType: new(samplerArgParseError),
+		},
+		{
+			sampler:     "jaeger_remote",
+			samplerArg:  "endpoint=http://localhost:14250,pollingIntervalMs=5000,initialSamplingRate=0.25",
+			description: NewJaegerRemoteSampler("", WithInitialSampler(TraceIDRatioBased(0.25))).Description(),
+		},
+		{
+			sampler:     "jaeger_remote",
+			samplerArg:  fmt.Sprintf("initialSamplingRate=%g", -randFloat),
+			description: NewJaegerRemoteSampler("").Description(),
+			errorType:   errNegativeTraceIDRatio,
+		},
+		{
+			sampler:             "jaeger_remote",
+			argOptional:         true,
+			description:         NewJaegerRemoteSampler("").Description(),
+			invalidArgErrorType: new(samplerArgParseError),
+		},
+		{
+			sampler:     "parentbased_jaeger_remote",
+			samplerArg:  "endpoint=http://localhost:14250,pollingIntervalMs=5000,initialSamplingRate=0.25",
+			description: ParentBased(NewJaegerRemoteSampler("", WithInitialSampler(TraceIDRatioBased(0.25)))).Description(),
+		},
+		{
+			sampler:             "parentbased_jaeger_remote",
+			argOptional:         true,
+			description:         ParentBased(NewJaegerRemoteSampler("")).Description(),
+			invalidArgErrorType: new(samplerArgParseError),
+		},
 	}
 
 	handler.Reset()
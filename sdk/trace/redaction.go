@@ -0,0 +1,311 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trace // import "go.opentelemetry.io/otel/sdk/trace"
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"regexp"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// RedactionRule transforms an attribute of a span, span event, or span link
+// before it is exported. It returns the transformed attribute and if the
+// attribute is kept.
+type RedactionRule func(attribute.KeyValue) (attribute.KeyValue, bool)
+
+// keySet returns a set of keys.
+func keySet(keys []attribute.Key) map[attribute.Key]struct{} {
+	set := make(map[attribute.Key]struct{}, len(keys))
+	for _, k := range keys {
+		set[k] = struct{}{}
+	}
+	return set
+}
+
+// DropAttributes returns a RedactionRule that removes the attributes with
+// any of the keys.
+func DropAttributes(keys ...attribute.Key) RedactionRule {
+	set := keySet(keys)
+	return func(kv attribute.KeyValue) (attribute.KeyValue, bool) {
+		_, drop := set[kv.Key]
+		return kv, !drop
+	}
+}
+
+// HashAttributes returns a RedactionRule that replaces the value of the
+// attributes with any of the keys with the hex encoded HMAC-SHA256 of the
+// value using secret as the key. The hash of a value is the same for all
+// spans hashed with the same secret so hashed attributes can still be
+// correlated. The replaced values are always strings.
+//
+// Hashing pseudonymizes values, it does not anonymize them: anyone with the
+// secret can recompute the hash of a guessed value and compare it with the
+// exported one. The secret needs to be kept private and should be long and
+// random, otherwise values with few possibilities, e.g. user IDs or IP
+// addresses, can be recovered by hashing all of them.
+func HashAttributes(secret []byte, keys ...attribute.Key) RedactionRule {
+	set := keySet(keys)
+	secret = append([]byte(nil), secret...)
+	return func(kv attribute.KeyValue) (attribute.KeyValue, bool) {
+		if _, ok := set[kv.Key]; !ok {
+			return kv, true
+		}
+		mac := hmac.New(sha256.New, secret)
+		_, _ = mac.Write([]byte(kv.Value.Emit()))
+		return kv.Key.String(hex.EncodeToString(mac.Sum(nil))), true
+	}
+}
+
+// ReplaceAttributeValues returns a RedactionRule that replaces all matches
+// of re in the string and string slice values of the attributes with
+// replacement. Within replacement, $ signs are interpreted as in
+// regexp.Regexp.ReplaceAllString. If keys are passed, only the attributes
+// with any of the keys are transformed, otherwise all attributes are.
+func ReplaceAttributeValues(re *regexp.Regexp, replacement string, keys ...attribute.Key) RedactionRule {
+	set := keySet(keys)
+	return func(kv attribute.KeyValue) (attribute.KeyValue, bool) {
+		if _, ok := set[kv.Key]; len(set) > 0 && !ok {
+			return kv, true
+		}
+
+		switch kv.Value.Type() {
+		case attribute.STRING:
+			return kv.Key.String(re.ReplaceAllString(kv.Value.AsString(), replacement)), true
+		case attribute.STRINGSLICE:
+			values := kv.Value.AsStringSlice()
+			for i, v := range values {
+				values[i] = re.ReplaceAllString(v, replacement)
+			}
+			return kv.Key.StringSlice(values), true
+		default:
+			return kv, true
+		}
+	}
+}
+
+// redactAttributes returns attrs transformed by rules and if any of them
+// were changed. The rules are applied in order until one drops an attribute.
+func redactAttributes(attrs []attribute.KeyValue, rules []RedactionRule) ([]attribute.KeyValue, bool) {
+	if len(attrs) == 0 {
+		return attrs, false
+	}
+
+	var changed bool
+	redacted := make([]attribute.KeyValue, 0, len(attrs))
+	for _, kv := range attrs {
+		orig, keep := kv, true
+		for _, rule := range rules {
+			if kv, keep = rule(kv); !keep {
+				break
+			}
+		}
+		if !keep {
+			changed = true
+			continue
+		}
+		changed = changed || kv != orig
+		redacted = append(redacted, kv)
+	}
+	if !changed {
+		return attrs, false
+	}
+	return redacted, true
+}
+
+// redactSpan returns s with its attributes, and the attributes of its events
+// and links, transformed by rules. If no attribute is changed, s is
+// returned. The dropped attribute counts of s are preserved.
+func redactSpan(s ReadOnlySpan, rules []RedactionRule) ReadOnlySpan {
+	attrs, changed := redactAttributes(s.Attributes(), rules)
+
+	events := s.Events()
+	var redactedEvents []Event
+	for i, e := range events {
+		eAttrs, ok := redactAttributes(e.Attributes, rules)
+		if !ok {
+			continue
+		}
+		if redactedEvents == nil {
+			redactedEvents = append([]Event(nil), events...)
+		}
+		redactedEvents[i].Attributes = eAttrs
+	}
+	if redactedEvents != nil {
+		events, changed = redactedEvents, true
+	}
+
+	links := s.Links()
+	var redactedLinks []Link
+	for i, l := range links {
+		lAttrs, ok := redactAttributes(l.Attributes, rules)
+		if !ok {
+			continue
+		}
+		if redactedLinks == nil {
+			redactedLinks = append([]Link(nil), links...)
+		}
+		redactedLinks[i].Attributes = lAttrs
+	}
+	if redactedLinks != nil {
+		links, changed = redactedLinks, true
+	}
+
+	if !changed {
+		return s
+	}
+	return snapshot{
+		name:                  s.Name(),
+		spanContext:           s.SpanContext(),
+		parent:                s.Parent(),
+		spanKind:              s.SpanKind(),
+		startTime:             s.StartTime(),
+		endTime:               s.EndTime(),
+		attributes:            attrs,
+		events:                events,
+		links:                 links,
+		status:                s.Status(),
+		childSpanCount:        s.ChildSpanCount(),
+		droppedAttributeCount: s.DroppedAttributes(),
+		droppedEventCount:     s.DroppedEvents(),
+		droppedLinkCount:      s.DroppedLinks(),
+		resource:              s.Resource(),
+		instrumentationScope:  s.InstrumentationScope(),
+	}
+}
+
+// redactingSpanExporter is a SpanExporter that redacts the attributes of
+// spans before they are exported by another SpanExporter.
+type redactingSpanExporter struct {
+	exporter SpanExporter
+	rules    []RedactionRule
+}
+
+var _ SpanExporter = (*redactingSpanExporter)(nil)
+
+// NewRedactingSpanExporter returns a SpanExporter that transforms the
+// attributes of spans, and of their events and links, with rules before they
+// are exported with exporter. The rules are applied in order to each
+// attribute until one drops it.
+//
+// The returned SpanExporter can be used with any SpanProcessor, e.g. a
+// BatchSpanProcessor, so the spans are redacted when they are exported. The
+// spans passed to it are not modified.
+func NewRedactingSpanExporter(exporter SpanExporter, rules ...RedactionRule) SpanExporter {
+	return &redactingSpanExporter{exporter: exporter, rules: rules}
+}
+
+// ExportSpans exports spans after redacting their attributes.
+func (e *redactingSpanExporter) ExportSpans(ctx context.Context, spans []ReadOnlySpan) error {
+	if e.exporter == nil {
+		return nil
+	}
+
+	redacted := make([]ReadOnlySpan, len(spans))
+	for i, s := range spans {
+		redacted[i] = redactSpan(s, e.rules)
+	}
+	return e.exporter.ExportSpans(ctx, redacted)
+}
+
+// Shutdown shuts down the wrapped exporter.
+func (e *redactingSpanExporter) Shutdown(ctx context.Context) error {
+	if e.exporter == nil {
+		return nil
+	}
+	return e.exporter.Shutdown(ctx)
+}
+
+// MarshalLog is the marshaling function used by the logging system to represent this exporter.
+func (e *redactingSpanExporter) MarshalLog() interface{} {
+	return struct {
+		Type     string
+		Exporter SpanExporter
+		Rules    int
+	}{
+		Type:     "RedactingSpanExporter",
+		Exporter: e.exporter,
+		Rules:    len(e.rules),
+	}
+}
+
+// redactingSpanProcessor is a SpanProcessor that redacts the attributes of
+// ended spans before passing them to another SpanProcessor.
+type redactingSpanProcessor struct {
+	processor SpanProcessor
+	rules     []RedactionRule
+}
+
+var _ SpanProcessor = (*redactingSpanProcessor)(nil)
+
+// NewRedactingSpanProcessor returns a SpanProcessor that transforms the
+// attributes of ended spans, and of their events and links, with rules
+// before passing them to processor. The rules are applied in order to each
+// attribute until one drops it.
+//
+// The span is redacted when it ends, other SpanProcessors registered with
+// the TracerProvider still receive the span unmodified. If processor is nil,
+// the returned SpanProcessor does nothing.
+func NewRedactingSpanProcessor(processor SpanProcessor, rules ...RedactionRule) SpanProcessor {
+	return &redactingSpanProcessor{processor: processor, rules: rules}
+}
+
+// OnStart passes s to the wrapped SpanProcessor.
+func (p *redactingSpanProcessor) OnStart(parent context.Context, s ReadWriteSpan) {
+	if p.processor == nil {
+		return
+	}
+	p.processor.OnStart(parent, s)
+}
+
+// OnEnd passes s with its attributes redacted to the wrapped SpanProcessor.
+func (p *redactingSpanProcessor) OnEnd(s ReadOnlySpan) {
+	if p.processor == nil {
+		return
+	}
+	p.processor.OnEnd(redactSpan(s, p.rules))
+}
+
+// Shutdown shuts down the wrapped SpanProcessor.
+func (p *redactingSpanProcessor) Shutdown(ctx context.Context) error {
+	if p.processor == nil {
+		return nil
+	}
+	return p.processor.Shutdown(ctx)
+}
+
+// ForceFlush forces the wrapped SpanProcessor to export all ended spans.
+func (p *redactingSpanProcessor) ForceFlush(ctx context.Context) error {
+	if p.processor == nil {
+		return nil
+	}
+	return p.processor.ForceFlush(ctx)
+}
+
+// MarshalLog is the marshaling function used by the logging system to represent this Span Processor.
+func (p *redactingSpanProcessor) MarshalLog() interface{} {
+	return struct {
+		Type      string
+		Processor SpanProcessor
+		Rules     int
+	}{
+		Type:      "RedactingSpanProcessor",
+		Processor: p.processor,
+		Rules:     len(p.rules),
+	}
+}
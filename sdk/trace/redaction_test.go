@@ -0,0 +1,238 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trace_test
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	emailRegexp = regexp.MustCompile(`[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}`)
+	cardRegexp  = regexp.MustCompile(`\b(?:\d[ -]?){12}(\d{4})\b`)
+
+	hashSecret = []byte("redaction test secret")
+)
+
+func hmacHex(secret []byte, s string) string {
+	mac := hmac.New(sha256.New, secret)
+	_, _ = mac.Write([]byte(s))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestDropAttributes(t *testing.T) {
+	rule := sdktrace.DropAttributes("password", "token")
+
+	_, keep := rule(attribute.String("password", "hunter2"))
+	assert.False(t, keep)
+	_, keep = rule(attribute.Int("token", 1))
+	assert.False(t, keep)
+
+	kv, keep := rule(attribute.String("user", "alice"))
+	assert.True(t, keep)
+	assert.Equal(t, attribute.String("user", "alice"), kv)
+}
+
+func TestHashAttributes(t *testing.T) {
+	rule := sdktrace.HashAttributes(hashSecret, "user.id", "user.age")
+
+	kv, keep := rule(attribute.String("user.id", "alice"))
+	assert.True(t, keep)
+	assert.Equal(t, attribute.String("user.id", hmacHex(hashSecret, "alice")), kv)
+
+	other, _ := rule(attribute.String("user.id", "bob"))
+	assert.NotEqual(t, kv, other)
+
+	kv, _ = rule(attribute.Int("user.age", 42))
+	assert.Equal(t, attribute.String("user.age", hmacHex(hashSecret, "42")), kv, "non-string value")
+
+	kv, _ = rule(attribute.String("http.method", "GET"))
+	assert.Equal(t, attribute.String("http.method", "GET"), kv)
+
+	kv, _ = sdktrace.HashAttributes([]byte("other secret"), "user.id")(attribute.String("user.id", "alice"))
+	assert.Equal(t, attribute.String("user.id", hmacHex([]byte("other secret"), "alice")), kv)
+	assert.NotEqual(t, attribute.String("user.id", hmacHex(hashSecret, "alice")), kv, "hash independent of secret")
+}
+
+func TestReplaceAttributeValues(t *testing.T) {
+	all := sdktrace.ReplaceAttributeValues(emailRegexp, "[email]")
+	kv, keep := all(attribute.String("message", "contact alice@example.com or bob@example.org"))
+	assert.True(t, keep)
+	assert.Equal(t, attribute.String("message", "contact [email] or [email]"), kv)
+
+	orig := []string{"alice@example.com", "none"}
+	kv, _ = all(attribute.StringSlice("to", orig))
+	assert.Equal(t, attribute.StringSlice("to", []string{"[email]", "none"}), kv)
+	assert.Equal(t, []string{"alice@example.com", "none"}, orig, "original slice modified")
+
+	kv, _ = all(attribute.Int("count", 1))
+	assert.Equal(t, attribute.Int("count", 1), kv)
+
+	card := sdktrace.ReplaceAttributeValues(cardRegexp, "****-$1", "card")
+	kv, _ = card(attribute.String("card", "4111 1111 1111 1234"))
+	assert.Equal(t, attribute.String("card", "****-1234"), kv)
+	kv, _ = card(attribute.String("order", "4111 1111 1111 1234"))
+	assert.Equal(t, attribute.String("order", "4111 1111 1111 1234"), kv, "rule applied to other key")
+}
+
+var redactionRules = []sdktrace.RedactionRule{
+	sdktrace.DropAttributes("auth.token"),
+	sdktrace.HashAttributes(hashSecret, "user.id"),
+	sdktrace.ReplaceAttributeValues(emailRegexp, "[email]"),
+}
+
+// recordRedactedSpan records a span with attributes that need to be
+// redacted and flushes the TracerProvider. The attribute count limit makes
+// the span drop one attribute.
+func recordRedactedSpan(t *testing.T, opts ...sdktrace.TracerProviderOption) {
+	t.Helper()
+
+	opts = append(opts, sdktrace.WithSpanLimits(sdktrace.SpanLimits{
+		AttributeValueLengthLimit:   -1,
+		AttributeCountLimit:         3,
+		EventCountLimit:             -1,
+		LinkCountLimit:              -1,
+		AttributePerEventCountLimit: -1,
+		AttributePerLinkCountLimit:  -1,
+	}))
+	tp := sdktrace.NewTracerProvider(opts...)
+
+	link := trace.Link{
+		SpanContext: trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID: trace.TraceID{0x01},
+			SpanID:  trace.SpanID{0x01},
+		}),
+		Attributes: []attribute.KeyValue{attribute.String("auth.token", "secret")},
+	}
+	_, span := tp.Tracer("Redaction").Start(context.Background(), "span", trace.WithLinks(link))
+	span.SetAttributes(
+		attribute.String("auth.token", "secret"),
+		attribute.String("user.id", "alice"),
+		attribute.String("user.email", "alice@example.com"),
+		attribute.String("dropped", "by limit"),
+	)
+	span.AddEvent("event", trace.WithAttributes(
+		attribute.String("message", "mail sent to bob@example.org"),
+		attribute.Int("size", 10),
+	))
+	span.End()
+
+	require.NoError(t, tp.ForceFlush(context.Background()))
+}
+
+func assertRedacted(t *testing.T, got tracetest.SpanStubs) {
+	t.Helper()
+
+	require.Len(t, got, 1)
+	s := got[0]
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.String("user.id", hmacHex(hashSecret, "alice")),
+		attribute.String("user.email", "[email]"),
+	}, s.Attributes)
+	assert.Equal(t, 1, s.DroppedAttributes, "dropped attribute count not preserved")
+
+	require.Len(t, s.Events, 1)
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.String("message", "mail sent to [email]"),
+		attribute.Int("size", 10),
+	}, s.Events[0].Attributes)
+
+	require.Len(t, s.Links, 1)
+	assert.Empty(t, s.Links[0].Attributes)
+}
+
+func assertUnmodified(t *testing.T, got tracetest.SpanStubs) {
+	t.Helper()
+
+	require.Len(t, got, 1)
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.String("auth.token", "secret"),
+		attribute.String("user.id", "alice"),
+		attribute.String("user.email", "alice@example.com"),
+	}, got[0].Attributes)
+}
+
+func TestRedactingSpanExporter(t *testing.T) {
+	redacted := tracetest.NewInMemoryExporter()
+	unmodified := tracetest.NewInMemoryExporter()
+	recordRedactedSpan(t,
+		sdktrace.WithBatcher(sdktrace.NewRedactingSpanExporter(redacted, redactionRules...)),
+		sdktrace.WithSyncer(unmodified),
+	)
+
+	assertRedacted(t, redacted.GetSpans())
+	// The span is shared with other processors and needs to be unmodified.
+	assertUnmodified(t, unmodified.GetSpans())
+}
+
+func TestRedactingSpanProcessor(t *testing.T) {
+	redacted := tracetest.NewInMemoryExporter()
+	unmodified := tracetest.NewInMemoryExporter()
+	recordRedactedSpan(t,
+		sdktrace.WithSpanProcessor(sdktrace.NewRedactingSpanProcessor(
+			sdktrace.NewSimpleSpanProcessor(redacted),
+			redactionRules...,
+		)),
+		sdktrace.WithSyncer(unmodified),
+	)
+
+	assertRedacted(t, redacted.GetSpans())
+	assertUnmodified(t, unmodified.GetSpans())
+}
+
+func TestRedactingSpanExporterNoRules(t *testing.T) {
+	exp := tracetest.NewInMemoryExporter()
+	recordRedactedSpan(t, sdktrace.WithSyncer(sdktrace.NewRedactingSpanExporter(exp)))
+	assertUnmodified(t, exp.GetSpans())
+}
+
+func TestRedactingSpanExporterShutdown(t *testing.T) {
+	exp := &testBatchExporter{}
+	redacting := sdktrace.NewRedactingSpanExporter(exp, redactionRules...)
+	require.NoError(t, redacting.Shutdown(context.Background()))
+	assert.Equal(t, 1, exp.shutdownCount)
+}
+
+func TestNewRedactingSpanExporterWithNilExporter(t *testing.T) {
+	redacting := sdktrace.NewRedactingSpanExporter(nil, redactionRules...)
+	spans := tracetest.SpanStubs{{Name: "span"}}.Snapshots()
+
+	// These should not panic.
+	assert.NoError(t, redacting.ExportSpans(context.Background(), spans))
+	assert.NoError(t, redacting.Shutdown(context.Background()))
+}
+
+func TestNewRedactingSpanProcessorWithNilProcessor(t *testing.T) {
+	redacting := sdktrace.NewRedactingSpanProcessor(nil, redactionRules...)
+
+	// These should not panic.
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(redacting))
+	_, span := tp.Tracer("TestNewRedactingSpanProcessorWithNilProcessor").Start(context.Background(), "span")
+	span.End()
+	assert.NoError(t, redacting.ForceFlush(context.Background()))
+	assert.NoError(t, redacting.Shutdown(context.Background()))
+}
@@ -20,6 +20,10 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/sdk/resource"
+	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
 )
 
 const (
@@ -32,6 +36,14 @@ const (
 	samplerParentBasedAlwaysOn     = "parentbased_always_on"
 	samplerParsedBasedAlwaysOff    = "parentbased_always_off"
 	samplerParentBasedTraceIDRatio = "parentbased_traceidratio"
+	samplerRateLimited             = "ratelimited"
+	samplerParentBasedRateLimited  = "parentbased_ratelimited"
+	samplerJaegerRemote            = "jaeger_remote"
+	samplerParentBasedJaegerRemote = "parentbased_jaeger_remote"
+
+	// defaultSpansPerSecond is the rate limit used by a RateLimited sampler
+	// configured from the environment without a valid sampler argument.
+	defaultSpansPerSecond = 1.0
 )
 
 type errUnsupportedSampler string
@@ -43,6 +55,7 @@ func (e errUnsupportedSampler) Error() string {
 var (
 	errNegativeTraceIDRatio       = errors.New("invalid trace ID ratio: less than 0.0")
 	errGreaterThanOneTraceIDRatio = errors.New("invalid trace ID ratio: greater than 1.0")
+	errNegativeRateLimit          = errors.New("invalid rate limit: less than 0.0")
 )
 
 type samplerArgParseError struct {
@@ -87,6 +100,22 @@ func samplerFromEnv() (Sampler, error) {
 		}
 		ratio, err := parseTraceIDRatio(samplerArg)
 		return ParentBased(ratio), err
+	case samplerRateLimited:
+		if !hasSamplerArg {
+			return RateLimited(defaultSpansPerSecond), nil
+		}
+		return parseRateLimit(samplerArg)
+	case samplerParentBasedRateLimited:
+		if !hasSamplerArg {
+			return ParentBased(RateLimited(defaultSpansPerSecond)), nil
+		}
+		limited, err := parseRateLimit(samplerArg)
+		return ParentBased(limited), err
+	case samplerJaegerRemote:
+		return jaegerRemoteFromEnv(samplerArg)
+	case samplerParentBasedJaegerRemote:
+		remote, err := jaegerRemoteFromEnv(samplerArg)
+		return ParentBased(remote), err
 	default:
 		return nil, errUnsupportedSampler(sampler)
 	}
@@ -106,3 +135,87 @@ func parseTraceIDRatio(arg string) (Sampler, error) {
 
 	return TraceIDRatioBased(v), nil
 }
+
+func parseRateLimit(arg string) (Sampler, error) {
+	v, err := strconv.ParseFloat(arg, 64)
+	if err != nil {
+		return RateLimited(defaultSpansPerSecond), samplerArgParseError{err}
+	}
+	if v < 0.0 {
+		return RateLimited(defaultSpansPerSecond), errNegativeRateLimit
+	}
+
+	return RateLimited(v), nil
+}
+
+// jaegerRemoteFromEnv returns a JaegerRemoteSampler configured with arg. Its
+// service name is resolved from the Resource of the TracerProvider by
+// resolveJaegerRemoteService. The arg is a comma separated list of key=value
+// pairs with the following keys:
+//   - endpoint: the URL of the sampling endpoint
+//   - pollingIntervalMs: the interval in milliseconds strategies are fetched at
+//   - initialSamplingRate: the trace ID ratio sampled until a strategy is fetched
+//
+// Unknown keys are ignored. If a value is invalid, the default for its key is
+// used and an error is returned.
+func jaegerRemoteFromEnv(arg string) (Sampler, error) {
+	var (
+		opts     []JaegerRemoteSamplerOption
+		firstErr error
+	)
+	setErr := func(err error) {
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+	for _, field := range strings.Split(arg, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		key, value, ok := strings.Cut(field, "=")
+		if !ok {
+			setErr(samplerArgParseError{fmt.Errorf("invalid key-value pair: %q", field)})
+			continue
+		}
+		key, value = strings.TrimSpace(key), strings.TrimSpace(value)
+
+		switch key {
+		case "endpoint":
+			opts = append(opts, WithSamplingServerURL(value))
+		case "pollingIntervalMs":
+			ms, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				setErr(samplerArgParseError{err})
+				continue
+			}
+			opts = append(opts, WithSamplingRefreshInterval(time.Duration(ms)*time.Millisecond))
+		case "initialSamplingRate":
+			ratio, err := parseTraceIDRatio(value)
+			if err != nil {
+				setErr(err)
+				continue
+			}
+			opts = append(opts, WithInitialSampler(ratio))
+		}
+	}
+
+	s := NewJaegerRemoteSampler("", opts...)
+	s.serviceFromResource = true
+	return s, firstErr
+}
+
+// resolveJaegerRemoteService sets the service name of the JaegerRemoteSampler
+// created by jaegerRemoteFromEnv, used as s or as the root of s, to the
+// service name of res.
+func resolveJaegerRemoteService(s Sampler, res *resource.Resource) {
+	if pb, ok := s.(parentBased); ok {
+		s = pb.root
+	}
+	remote, ok := s.(*JaegerRemoteSampler)
+	if !ok || !remote.serviceFromResource {
+		return
+	}
+	svc, _ := res.Set().Value(semconv.ServiceNameKey)
+	remote.serviceName = svc.AsString()
+}
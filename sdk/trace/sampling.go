@@ -18,6 +18,9 @@ import (
 	"context"
 	"encoding/binary"
 	"fmt"
+	"math"
+	"sync"
+	"time"
 
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
@@ -119,6 +122,84 @@ func TraceIDRatioBased(fraction float64) Sampler {
 	}
 }
 
+type rateLimitedSampler struct {
+	rate        float64
+	capacity    float64
+	description string
+	now         func() time.Time
+
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+func (rs *rateLimitedSampler) ShouldSample(p SamplingParameters) SamplingResult {
+	psc := trace.SpanContextFromContext(p.ParentContext)
+	if rs.take() {
+		return SamplingResult{
+			Decision:   RecordAndSample,
+			Tracestate: psc.TraceState(),
+		}
+	}
+	return SamplingResult{
+		Decision:   Drop,
+		Tracestate: psc.TraceState(),
+	}
+}
+
+// take refills the token bucket of rs for the time passed since the last
+// call and returns if a token could be taken from it.
+func (rs *rateLimitedSampler) take() bool {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+
+	now := rs.now()
+	if elapsed := now.Sub(rs.last); elapsed > 0 {
+		rs.tokens = math.Min(rs.capacity, rs.tokens+elapsed.Seconds()*rs.rate)
+		rs.last = now
+	}
+	if rs.tokens < 1 {
+		return false
+	}
+	rs.tokens--
+	return true
+}
+
+func (rs *rateLimitedSampler) Description() string {
+	return rs.description
+}
+
+// RateLimited samples at most spansPerSecond spans per second, regardless of
+// the rate spans are started at. It uses a token bucket that holds up to one
+// second worth of spans, or a single span for rates below one span per
+// second, so short bursts within the limit are all sampled. Rates <= 0 will
+// never sample and an infinite rate will always sample. To respect the parent
+// trace's `SampledFlag` and limit only the number of sampled root spans, the
+// `RateLimited` sampler should be used as a delegate of a `Parent` sampler.
+func RateLimited(spansPerSecond float64) Sampler {
+	if math.IsInf(spansPerSecond, 1) {
+		return AlwaysSample()
+	}
+
+	if !(spansPerSecond > 0) {
+		spansPerSecond = 0
+	}
+
+	capacity := spansPerSecond
+	if capacity > 0 && capacity < 1 {
+		capacity = 1
+	}
+
+	return &rateLimitedSampler{
+		rate:        spansPerSecond,
+		capacity:    capacity,
+		description: fmt.Sprintf("RateLimited{%g}", spansPerSecond),
+		now:         time.Now,
+		tokens:      capacity,
+		last:        time.Now(),
+	}
+}
+
 type alwaysOnSampler struct{}
 
 func (as alwaysOnSampler) ShouldSample(p SamplingParameters) SamplingResult {
@@ -17,8 +17,11 @@ package trace
 import (
 	"context"
 	"fmt"
+	"math"
 	"math/rand"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -210,6 +213,120 @@ func TestTraceIdRatioSamplesInclusively(t *testing.T) {
 	}
 }
 
+// fixedClockRateLimited returns a RateLimited sampler that uses the returned
+// clock to determine the current time.
+func fixedClockRateLimited(t *testing.T, spansPerSecond float64) (Sampler, *time.Time) {
+	t.Helper()
+
+	s, ok := RateLimited(spansPerSecond).(*rateLimitedSampler)
+	require.True(t, ok, "not a rate limited sampler")
+	now := s.last
+	s.now = func() time.Time { return now }
+	return s, &now
+}
+
+func sampledN(s Sampler, n int) int {
+	var sampled int
+	for i := 0; i < n; i++ {
+		if s.ShouldSample(SamplingParameters{ParentContext: context.Background()}).Decision == RecordAndSample {
+			sampled++
+		}
+	}
+	return sampled
+}
+
+func TestRateLimitedSampler(t *testing.T) {
+	s, now := fixedClockRateLimited(t, 2)
+	assert.Equal(t, "RateLimited{2}", s.Description())
+
+	assert.Equal(t, 2, sampledN(s, 10), "initial burst")
+
+	*now = now.Add(500 * time.Millisecond)
+	assert.Equal(t, 1, sampledN(s, 10), "refill after 500ms")
+
+	*now = now.Add(10 * time.Second)
+	assert.Equal(t, 2, sampledN(s, 10), "refill is limited to a second worth of spans")
+}
+
+func TestRateLimitedSamplerFractionalRate(t *testing.T) {
+	s, now := fixedClockRateLimited(t, 0.5)
+	assert.Equal(t, "RateLimited{0.5}", s.Description())
+
+	assert.Equal(t, 1, sampledN(s, 10), "initial burst")
+
+	*now = now.Add(time.Second)
+	assert.Equal(t, 0, sampledN(s, 10), "refill after 1s")
+
+	*now = now.Add(time.Second)
+	assert.Equal(t, 1, sampledN(s, 10), "refill after 2s")
+}
+
+func TestRateLimitedSamplerClockSkew(t *testing.T) {
+	s, now := fixedClockRateLimited(t, 1)
+	require.Equal(t, 1, sampledN(s, 10))
+
+	*now = now.Add(-time.Hour)
+	assert.Equal(t, 0, sampledN(s, 10), "clock moving backwards refilled tokens")
+}
+
+func TestRateLimitedSamplerLimits(t *testing.T) {
+	for _, rate := range []float64{0, -1, math.NaN()} {
+		s := RateLimited(rate)
+		assert.Equal(t, "RateLimited{0}", s.Description())
+		assert.Equal(t, 0, sampledN(s, 10), "rate: %g", rate)
+	}
+
+	s := RateLimited(math.Inf(1))
+	assert.Equal(t, AlwaysSample().Description(), s.Description())
+	assert.Equal(t, 10, sampledN(s, 10))
+}
+
+func TestRateLimitedSamplerConcurrentSafe(t *testing.T) {
+	const goroutines, spans = 10, 100
+	s, _ := fixedClockRateLimited(t, 50)
+
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		sampled int
+	)
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			n := sampledN(s, spans)
+			mu.Lock()
+			sampled += n
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, 50, sampled)
+}
+
+func TestParentBasedRateLimited(t *testing.T) {
+	root, _ := fixedClockRateLimited(t, 1)
+	sampler := ParentBased(root)
+
+	require.Equal(t, 1, sampledN(sampler, 10), "root spans not rate limited")
+
+	traceID, _ := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	spanID, _ := trace.SpanIDFromHex("00f067aa0ba902b7")
+	parentCtx := trace.ContextWithRemoteSpanContext(
+		context.Background(),
+		trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID:    traceID,
+			SpanID:     spanID,
+			TraceFlags: trace.FlagsSampled,
+		}),
+	)
+	for i := 0; i < 10; i++ {
+		params := SamplingParameters{ParentContext: parentCtx, TraceID: traceID}
+		assert.Equal(t, RecordAndSample, sampler.ShouldSample(params).Decision, "sampled parent was rate limited")
+	}
+}
+
 func TestTracestateIsPassed(t *testing.T) {
 	testCases := []struct {
 		name    string
@@ -235,6 +352,10 @@ func TestTracestateIsPassed(t *testing.T) {
 			"traceIDRatioSampler",
 			TraceIDRatioBased(.5),
 		},
+		{
+			"rateLimitedSampler",
+			RateLimited(1),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,213 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trace // import "go.opentelemetry.io/otel/sdk/trace"
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+// TailSamplingPolicy decides whether a trace is exported by a tail sampling
+// SpanProcessor once the spans of the trace have been buffered.
+type TailSamplingPolicy interface {
+	// ShouldKeep returns true if the trace comprised of spans should be
+	// exported.
+	//
+	// The spans are the ended spans of a single trace buffered by the
+	// processor. They are not guaranteed to be the complete trace, parts of
+	// the trace may have been recorded by other processes or may have not
+	// ended yet.
+	ShouldKeep(spans []ReadOnlySpan) bool
+
+	// Description returns information describing the TailSamplingPolicy.
+	Description() string
+}
+
+type errorPolicy struct{}
+
+func (errorPolicy) ShouldKeep(spans []ReadOnlySpan) bool {
+	for _, s := range spans {
+		if s.Status().Code == codes.Error {
+			return true
+		}
+	}
+	return false
+}
+
+func (errorPolicy) Description() string {
+	return "ErrorPolicy"
+}
+
+// ErrorPolicy returns a TailSamplingPolicy that keeps traces containing a
+// span with an Error status.
+func ErrorPolicy() TailSamplingPolicy {
+	return errorPolicy{}
+}
+
+type latencyPolicy struct {
+	threshold time.Duration
+}
+
+func (lp latencyPolicy) ShouldKeep(spans []ReadOnlySpan) bool {
+	if len(spans) == 0 {
+		return false
+	}
+	start, end := spans[0].StartTime(), spans[0].EndTime()
+	for _, s := range spans[1:] {
+		if s.StartTime().Before(start) {
+			start = s.StartTime()
+		}
+		if s.EndTime().After(end) {
+			end = s.EndTime()
+		}
+	}
+	return end.Sub(start) >= lp.threshold
+}
+
+func (lp latencyPolicy) Description() string {
+	return fmt.Sprintf("LatencyPolicy{%s}", lp.threshold)
+}
+
+// LatencyPolicy returns a TailSamplingPolicy that keeps traces that took at
+// least threshold to complete. The duration of a trace is measured from the
+// earliest start to the latest end of its spans.
+func LatencyPolicy(threshold time.Duration) TailSamplingPolicy {
+	return latencyPolicy{threshold: threshold}
+}
+
+type attributePolicy struct {
+	key    attribute.Key
+	values []attribute.Value
+}
+
+func (ap attributePolicy) ShouldKeep(spans []ReadOnlySpan) bool {
+	for _, s := range spans {
+		for _, attr := range s.Attributes() {
+			if attr.Key == ap.key && ap.matches(attr.Value) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// matches returns if v is one of the values ap matches. If ap has no values,
+// all values match.
+func (ap attributePolicy) matches(v attribute.Value) bool {
+	if len(ap.values) == 0 {
+		return true
+	}
+	for _, want := range ap.values {
+		if v == want {
+			return true
+		}
+	}
+	return false
+}
+
+func (ap attributePolicy) Description() string {
+	values := make([]string, len(ap.values))
+	for i, v := range ap.values {
+		values[i] = v.Emit()
+	}
+	return fmt.Sprintf("AttributePolicy{%s:[%s]}", ap.key, strings.Join(values, ","))
+}
+
+// AttributePolicy returns a TailSamplingPolicy that keeps traces containing a
+// span with an attribute of key and one of values. If no values are provided,
+// traces containing a span with an attribute of key with any value are kept.
+func AttributePolicy(key attribute.Key, values ...attribute.Value) TailSamplingPolicy {
+	return attributePolicy{key: key, values: values}
+}
+
+type probabilisticPolicy struct {
+	traceIDUpperBound uint64
+	description       string
+}
+
+func (pp probabilisticPolicy) ShouldKeep(spans []ReadOnlySpan) bool {
+	if len(spans) == 0 {
+		return false
+	}
+	traceID := spans[0].SpanContext().TraceID()
+	x := binary.BigEndian.Uint64(traceID[0:8]) >> 1
+	return x < pp.traceIDUpperBound
+}
+
+func (pp probabilisticPolicy) Description() string {
+	return pp.description
+}
+
+// ProbabilisticPolicy returns a TailSamplingPolicy that keeps a given
+// fraction of traces. The decision is based on the trace ID, the same way the
+// TraceIDRatioBased Sampler makes its decision. Fractions >= 1 will always
+// keep traces. Fractions < 0 are treated as zero.
+func ProbabilisticPolicy(fraction float64) TailSamplingPolicy {
+	if fraction >= 1 {
+		fraction = 1
+	}
+	if fraction <= 0 {
+		fraction = 0
+	}
+	return probabilisticPolicy{
+		traceIDUpperBound: uint64(fraction * (1 << 63)),
+		description:       fmt.Sprintf("ProbabilisticPolicy{%g}", fraction),
+	}
+}
+
+type compositePolicy struct {
+	policies []TailSamplingPolicy
+	// all is true if all policies need to keep a trace for it to be kept,
+	// otherwise any one of them keeping the trace is enough.
+	all bool
+}
+
+func (cp compositePolicy) ShouldKeep(spans []ReadOnlySpan) bool {
+	for _, p := range cp.policies {
+		if p.ShouldKeep(spans) != cp.all {
+			return !cp.all
+		}
+	}
+	return cp.all
+}
+
+func (cp compositePolicy) Description() string {
+	name := "OrPolicy"
+	if cp.all {
+		name = "AndPolicy"
+	}
+	descriptions := make([]string, len(cp.policies))
+	for i, p := range cp.policies {
+		descriptions[i] = p.Description()
+	}
+	return fmt.Sprintf("%s{%s}", name, strings.Join(descriptions, ","))
+}
+
+// AndPolicy returns a TailSamplingPolicy that keeps traces all of policies
+// keep. If no policies are provided, all traces are kept.
+func AndPolicy(policies ...TailSamplingPolicy) TailSamplingPolicy {
+	return compositePolicy{policies: policies, all: true}
+}
+
+// OrPolicy returns a TailSamplingPolicy that keeps traces any of policies
+// keep. If no policies are provided, no traces are kept.
+func OrPolicy(policies ...TailSamplingPolicy) TailSamplingPolicy {
+	return compositePolicy{policies: policies}
+}
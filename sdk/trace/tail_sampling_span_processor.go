@@ -0,0 +1,366 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trace // import "go.opentelemetry.io/otel/sdk/trace"
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Defaults for TailSamplingSpanProcessorOptions.
+const (
+	DefaultDecisionWait     = 30000
+	DefaultMaxBufferedSpans = 10000
+)
+
+// TailSamplingSpanProcessorOption configures a tail sampling SpanProcessor.
+type TailSamplingSpanProcessorOption func(o *TailSamplingSpanProcessorOptions)
+
+// TailSamplingSpanProcessorOptions is configuration settings for a tail
+// sampling SpanProcessor.
+type TailSamplingSpanProcessorOptions struct {
+	// DecisionWait is the maximum duration the spans of a trace are buffered
+	// waiting for the local root span of the trace to end. Once it has
+	// passed, the decision to keep the trace is made with the spans that
+	// have been buffered. The decision made for a trace is applied to the
+	// spans of the trace that end after it was made, and is remembered until
+	// it has not been applied for the same duration.
+	// The default value of DecisionWait is 30000 msec.
+	DecisionWait time.Duration
+
+	// MaxBufferedSpans is the maximum number of spans buffered across all
+	// traces waiting for a decision. Each remembered decision counts as one
+	// buffered span. If it is exceeded, the least recently applied decisions
+	// are forgotten, and then the decision for the oldest traces is made
+	// early, until the number of buffered spans is within the limit again.
+	// The default value of MaxBufferedSpans is 10000.
+	MaxBufferedSpans int
+}
+
+// WithDecisionWait returns a TailSamplingSpanProcessorOption that configures
+// the maximum duration the spans of a trace are buffered before a decision
+// to keep the trace is made.
+func WithDecisionWait(d time.Duration) TailSamplingSpanProcessorOption {
+	return func(o *TailSamplingSpanProcessorOptions) {
+		o.DecisionWait = d
+	}
+}
+
+// WithMaxBufferedSpans returns a TailSamplingSpanProcessorOption that
+// configures the maximum number of spans buffered while waiting for a
+// decision to keep their trace.
+func WithMaxBufferedSpans(n int) TailSamplingSpanProcessorOption {
+	return func(o *TailSamplingSpanProcessorOptions) {
+		o.MaxBufferedSpans = n
+	}
+}
+
+// tailSamplingSpanProcessor is a SpanProcessor that buffers the spans of a
+// trace until a decision to keep them is made by a TailSamplingPolicy.
+type tailSamplingSpanProcessor struct {
+	next   SpanProcessor
+	policy TailSamplingPolicy
+	o      TailSamplingSpanProcessorOptions
+
+	mu     sync.Mutex
+	traces map[trace.TraceID]*tailTrace
+	// pending are the traces waiting for a decision, oldest first.
+	pending *list.List
+	// decided are the traces a decision has been made for, least recently
+	// applied first.
+	decided  *list.List
+	buffered int
+	stopped  bool
+
+	stopOnce sync.Once
+}
+
+// tailTrace is the state of a trace tracked by a tailSamplingSpanProcessor.
+type tailTrace struct {
+	id    trace.TraceID
+	spans []ReadOnlySpan
+	// elem is the element of the trace in the pending traces of the
+	// processor, or in the decided traces once a decision has been made.
+	elem    *list.Element
+	decided bool
+	keep    bool
+	// timer makes the decision for the trace once the decision wait has
+	// passed. It is nil once a decision has been made.
+	timer *time.Timer
+	// applied is the last time the decision was applied to a span.
+	applied time.Time
+}
+
+var _ SpanProcessor = (*tailSamplingSpanProcessor)(nil)
+
+// NewTailSamplingSpanProcessor returns a new SpanProcessor that buffers the
+// spans of each trace until the local root span of the trace ends, or the
+// decision wait passes, and then uses policy to decide if the spans of the
+// trace are passed on to next.
+//
+// A local root span is a span without a parent or with a remote parent. The
+// spans of a trace that are recorded by other processes are never seen by
+// this SpanProcessor, policy decides with the spans recorded locally.
+//
+// The spans of kept traces are passed to next on the goroutine that made the
+// decision, usually the one ending the local root span. Use a
+// BatchSpanProcessor as next to export them asynchronously.
+//
+// If next is nil, the span processor will perform no action.
+func NewTailSamplingSpanProcessor(next SpanProcessor, policy TailSamplingPolicy, options ...TailSamplingSpanProcessorOption) SpanProcessor {
+	o := TailSamplingSpanProcessorOptions{
+		DecisionWait:     time.Duration(DefaultDecisionWait) * time.Millisecond,
+		MaxBufferedSpans: DefaultMaxBufferedSpans,
+	}
+	for _, opt := range options {
+		opt(&o)
+	}
+	return &tailSamplingSpanProcessor{
+		next:    next,
+		policy:  policy,
+		o:       o,
+		traces:  make(map[trace.TraceID]*tailTrace),
+		pending: list.New(),
+		decided: list.New(),
+	}
+}
+
+// OnStart does nothing.
+func (tsp *tailSamplingSpanProcessor) OnStart(context.Context, ReadWriteSpan) {}
+
+// OnEnd buffers s until a decision to keep its trace is made. If s is the
+// local root span of its trace, the decision is made and the spans of the
+// trace are passed to the next SpanProcessor if it is kept.
+func (tsp *tailSamplingSpanProcessor) OnEnd(s ReadOnlySpan) {
+	if tsp.next == nil || !s.SpanContext().IsSampled() {
+		return
+	}
+
+	id := s.SpanContext().TraceID()
+	tsp.mu.Lock()
+	if tsp.stopped {
+		tsp.mu.Unlock()
+		return
+	}
+
+	now := time.Now()
+	tsp.expire(now)
+
+	t, ok := tsp.traces[id]
+	if ok && t.decided {
+		// Apply the decision to the late span.
+		t.applied = now
+		tsp.decided.MoveToBack(t.elem)
+		keep := t.keep
+		tsp.mu.Unlock()
+		if keep {
+			tsp.next.OnEnd(s)
+		}
+		return
+	}
+	if !ok {
+		t = &tailTrace{id: id}
+		t.elem = tsp.pending.PushBack(t)
+		t.timer = time.AfterFunc(tsp.o.DecisionWait, func() { tsp.timeout(t) })
+		tsp.traces[id] = t
+	}
+
+	t.spans = append(t.spans, s)
+	tsp.buffered++
+
+	var kept []ReadOnlySpan
+	if isLocalRoot(s) {
+		kept = append(kept, tsp.decide(t, now)...)
+	}
+	kept = append(kept, tsp.enforceLimit(now)...)
+	tsp.mu.Unlock()
+
+	tsp.forward(kept)
+}
+
+// isLocalRoot returns if s is the root span of the local part of its trace.
+func isLocalRoot(s ReadOnlySpan) bool {
+	parent := s.Parent()
+	return !parent.IsValid() || parent.IsRemote()
+}
+
+// decide makes the decision to keep t and returns the spans of t to pass on.
+// The decision is remembered so it can be applied to spans of the trace that
+// end later.
+//
+// The tsp.mu lock needs to be held when calling this.
+func (tsp *tailSamplingSpanProcessor) decide(t *tailTrace, now time.Time) []ReadOnlySpan {
+	tsp.pending.Remove(t.elem)
+	t.timer.Stop()
+	t.timer = nil
+	tsp.buffered -= len(t.spans)
+
+	spans := t.spans
+	t.spans = nil
+	t.keep = tsp.policy.ShouldKeep(spans)
+	t.decided = true
+	t.applied = now
+	t.elem = tsp.decided.PushBack(t)
+
+	if !t.keep {
+		return nil
+	}
+	return spans
+}
+
+// enforceLimit forgets decisions and makes the decision for pending traces
+// until the buffered spans and remembered decisions are within the
+// MaxBufferedSpans limit. It returns the spans of the kept traces.
+//
+// The tsp.mu lock needs to be held when calling this.
+func (tsp *tailSamplingSpanProcessor) enforceLimit(now time.Time) []ReadOnlySpan {
+	var kept []ReadOnlySpan
+	for tsp.o.MaxBufferedSpans > 0 && tsp.buffered+tsp.decided.Len() > tsp.o.MaxBufferedSpans {
+		if e := tsp.decided.Front(); e != nil {
+			tsp.forget(e)
+			continue
+		}
+		kept = append(kept, tsp.decide(tsp.pending.Front().Value.(*tailTrace), now)...)
+	}
+	return kept
+}
+
+// expire forgets the decisions that have not been applied for the decision
+// wait.
+//
+// The tsp.mu lock needs to be held when calling this.
+func (tsp *tailSamplingSpanProcessor) expire(now time.Time) {
+	for e := tsp.decided.Front(); e != nil; e = tsp.decided.Front() {
+		if now.Sub(e.Value.(*tailTrace).applied) < tsp.o.DecisionWait {
+			return
+		}
+		tsp.forget(e)
+	}
+}
+
+// forget removes the decision of the decided trace of e.
+//
+// The tsp.mu lock needs to be held when calling this.
+func (tsp *tailSamplingSpanProcessor) forget(e *list.Element) {
+	t := tsp.decided.Remove(e).(*tailTrace)
+	delete(tsp.traces, t.id)
+}
+
+// timeout makes the decision for t if it has not already been made and
+// passes on its spans if it is kept.
+func (tsp *tailSamplingSpanProcessor) timeout(t *tailTrace) {
+	tsp.mu.Lock()
+	if tsp.stopped || t.decided {
+		tsp.mu.Unlock()
+		return
+	}
+	spans := tsp.decide(t, time.Now())
+	tsp.mu.Unlock()
+
+	tsp.forward(spans)
+}
+
+// decideAll makes the decision for all pending traces and returns the spans
+// of the kept traces.
+//
+// The tsp.mu lock needs to be held when calling this.
+func (tsp *tailSamplingSpanProcessor) decideAll() []ReadOnlySpan {
+	var kept []ReadOnlySpan
+	now := time.Now()
+	for tsp.pending.Len() > 0 {
+		kept = append(kept, tsp.decide(tsp.pending.Front().Value.(*tailTrace), now)...)
+	}
+	return kept
+}
+
+// forward passes spans to the next SpanProcessor.
+func (tsp *tailSamplingSpanProcessor) forward(spans []ReadOnlySpan) {
+	for _, s := range spans {
+		tsp.next.OnEnd(s)
+	}
+}
+
+// ForceFlush makes the decision for all traces waiting for one, passes the
+// spans of the kept traces to the next SpanProcessor, and flushes it. If ctx
+// is already done, no decision is made and the traces keep waiting for one.
+func (tsp *tailSamplingSpanProcessor) ForceFlush(ctx context.Context) error {
+	if tsp.next == nil {
+		return nil
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	tsp.mu.Lock()
+	kept := tsp.decideAll()
+	tsp.mu.Unlock()
+
+	// The decided traces are no longer buffered, pass on all of them so
+	// none of the kept spans are lost.
+	tsp.forward(kept)
+	return tsp.next.ForceFlush(ctx)
+}
+
+// Shutdown makes the decision for all traces waiting for one, passes the
+// spans of the kept traces to the next SpanProcessor, and then shuts it down.
+// If ctx is already done, the traces waiting for a decision are dropped.
+// Spans that end after Shutdown is called are dropped.
+func (tsp *tailSamplingSpanProcessor) Shutdown(ctx context.Context) error {
+	var err error
+	tsp.stopOnce.Do(func() {
+		if tsp.next == nil {
+			return
+		}
+
+		tsp.mu.Lock()
+		var kept []ReadOnlySpan
+		if err = ctx.Err(); err == nil {
+			kept = tsp.decideAll()
+		}
+		tsp.stopped = true
+		for e := tsp.pending.Front(); e != nil; e = e.Next() {
+			e.Value.(*tailTrace).timer.Stop()
+		}
+		tsp.traces = nil
+		tsp.pending.Init()
+		tsp.decided.Init()
+		tsp.mu.Unlock()
+
+		tsp.forward(kept)
+		if shutdownErr := tsp.next.Shutdown(ctx); err == nil {
+			err = shutdownErr
+		}
+	})
+	return err
+}
+
+// MarshalLog is the marshaling function used by the logging system to represent this Span Processor.
+func (tsp *tailSamplingSpanProcessor) MarshalLog() interface{} {
+	return struct {
+		Type          string
+		SpanProcessor SpanProcessor
+		Policy        string
+		Config        TailSamplingSpanProcessorOptions
+	}{
+		Type:          "TailSamplingSpanProcessor",
+		SpanProcessor: tsp.next,
+		Policy:        tsp.policy.Description(),
+		Config:        tsp.o,
+	}
+}
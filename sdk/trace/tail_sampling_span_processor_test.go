@@ -0,0 +1,294 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trace_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// keepAll is a TailSamplingPolicy that keeps all traces.
+var keepAll = sdktrace.ProbabilisticPolicy(1)
+
+// tailSamplingTracer returns a Tracer using a tail sampling SpanProcessor
+// passing the kept spans synchronously to exp.
+func tailSamplingTracer(t *testing.T, exp sdktrace.SpanExporter, policy sdktrace.TailSamplingPolicy, opts ...sdktrace.TailSamplingSpanProcessorOption) (trace.Tracer, sdktrace.SpanProcessor) {
+	var next sdktrace.SpanProcessor
+	if exp != nil {
+		next = sdktrace.NewSimpleSpanProcessor(exp)
+	}
+	return tailSamplingTracerWith(t, next, policy, opts...)
+}
+
+func tailSamplingTracerWith(t *testing.T, next sdktrace.SpanProcessor, policy sdktrace.TailSamplingPolicy, opts ...sdktrace.TailSamplingSpanProcessorOption) (trace.Tracer, sdktrace.SpanProcessor) {
+	tsp := sdktrace.NewTailSamplingSpanProcessor(next, policy, opts...)
+	tp := basicTracerProvider(t)
+	tp.RegisterSpanProcessor(tsp)
+	return tp.Tracer("TailSampling"), tsp
+}
+
+func spanNames(spans []tracetest.SpanStub) []string {
+	names := make([]string, len(spans))
+	for i, s := range spans {
+		names[i] = s.Name
+	}
+	return names
+}
+
+func TestNewTailSamplingSpanProcessorWithNilSpanProcessor(t *testing.T) {
+	tr, tsp := tailSamplingTracer(t, nil, keepAll)
+
+	_, span := tr.Start(context.Background(), "foo")
+	span.End()
+
+	// These should not panic.
+	tsp.OnStart(context.Background(), span.(sdktrace.ReadWriteSpan))
+	tsp.OnEnd(span.(sdktrace.ReadOnlySpan))
+	assert.NoError(t, tsp.ForceFlush(context.Background()))
+	assert.NoError(t, tsp.Shutdown(context.Background()))
+}
+
+func TestTailSamplingSpanProcessorDecidesOnRootEnd(t *testing.T) {
+	exp := tracetest.NewInMemoryExporter()
+	tr, _ := tailSamplingTracer(t, exp, sdktrace.ErrorPolicy(), sdktrace.WithDecisionWait(time.Hour))
+
+	ctx, okRoot := tr.Start(context.Background(), "ok root")
+	_, okChild := tr.Start(ctx, "ok child")
+	ctx, errRoot := tr.Start(context.Background(), "error root")
+	_, errChild := tr.Start(ctx, "error child")
+	errChild.SetStatus(codes.Error, "failed")
+
+	okChild.End()
+	errChild.End()
+	assert.Empty(t, exp.GetSpans(), "spans exported before the root ended")
+
+	okRoot.End()
+	assert.Empty(t, exp.GetSpans(), "trace without an error exported")
+
+	errRoot.End()
+	assert.Equal(t, []string{"error child", "error root"}, spanNames(exp.GetSpans()))
+}
+
+func TestTailSamplingSpanProcessorPartlyLocalTrace(t *testing.T) {
+	exp := tracetest.NewInMemoryExporter()
+	tr, _ := tailSamplingTracer(t, exp, keepAll, sdktrace.WithDecisionWait(time.Hour))
+
+	remote := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{0x01},
+		SpanID:     trace.SpanID{0x01},
+		TraceFlags: trace.FlagsSampled,
+		Remote:     true,
+	})
+	ctx := trace.ContextWithRemoteSpanContext(context.Background(), remote)
+
+	ctx, localRoot := tr.Start(ctx, "local root")
+	_, child := tr.Start(ctx, "child")
+
+	child.End()
+	assert.Empty(t, exp.GetSpans(), "spans exported before the local root ended")
+
+	localRoot.End()
+	got := exp.GetSpans()
+	require.Equal(t, []string{"child", "local root"}, spanNames(got))
+	assert.Equal(t, remote.TraceID(), got[1].SpanContext.TraceID())
+	assert.True(t, got[1].Parent.IsRemote())
+}
+
+func TestTailSamplingSpanProcessorDecisionWait(t *testing.T) {
+	exp := tracetest.NewInMemoryExporter()
+	tr, _ := tailSamplingTracer(t, exp, keepAll, sdktrace.WithDecisionWait(10*time.Millisecond))
+
+	// The root span never ends, the decision is made once the decision wait
+	// has passed with the spans that have ended.
+	ctx, _ := tr.Start(context.Background(), "root")
+	_, child := tr.Start(ctx, "child")
+	child.End()
+
+	assert.Eventually(t, func() bool {
+		return len(exp.GetSpans()) == 1
+	}, time.Second, 5*time.Millisecond)
+	assert.Equal(t, []string{"child"}, spanNames(exp.GetSpans()))
+}
+
+func TestTailSamplingSpanProcessorLateSpans(t *testing.T) {
+	exp := tracetest.NewInMemoryExporter()
+	tr, _ := tailSamplingTracer(t, exp, sdktrace.ErrorPolicy(), sdktrace.WithDecisionWait(time.Hour))
+
+	ctx, keptRoot := tr.Start(context.Background(), "kept root")
+	_, keptLate := tr.Start(ctx, "kept late")
+	ctx, droppedRoot := tr.Start(context.Background(), "dropped root")
+	_, droppedLate := tr.Start(ctx, "dropped late")
+
+	keptRoot.SetStatus(codes.Error, "failed")
+	keptRoot.End()
+	droppedRoot.End()
+	require.Equal(t, []string{"kept root"}, spanNames(exp.GetSpans()))
+
+	// Spans ending after the decision was made follow that decision.
+	droppedLate.End()
+	keptLate.End()
+	assert.Equal(t, []string{"kept root", "kept late"}, spanNames(exp.GetSpans()))
+}
+
+func TestTailSamplingSpanProcessorMaxBufferedSpans(t *testing.T) {
+	exp := tracetest.NewInMemoryExporter()
+	tr, _ := tailSamplingTracer(
+		t, exp, keepAll,
+		sdktrace.WithDecisionWait(time.Hour),
+		sdktrace.WithMaxBufferedSpans(2),
+	)
+
+	var children []trace.Span
+	for _, name := range []string{"first", "second", "third"} {
+		ctx, _ := tr.Start(context.Background(), name+" root")
+		_, child := tr.Start(ctx, name)
+		children = append(children, child)
+	}
+
+	children[0].End()
+	children[1].End()
+	assert.Empty(t, exp.GetSpans(), "spans exported within the buffer limit")
+
+	// Exceeding the limit makes the decision for the oldest trace early.
+	children[2].End()
+	assert.Equal(t, []string{"first"}, spanNames(exp.GetSpans()))
+}
+
+func TestTailSamplingSpanProcessorDecisionLimit(t *testing.T) {
+	exp := tracetest.NewInMemoryExporter()
+	tr, _ := tailSamplingTracer(
+		t, exp, keepAll,
+		sdktrace.WithDecisionWait(time.Hour),
+		sdktrace.WithMaxBufferedSpans(2),
+	)
+
+	var late []trace.Span
+	for _, name := range []string{"first", "second", "third"} {
+		ctx, root := tr.Start(context.Background(), name+" root")
+		_, child := tr.Start(ctx, name)
+		late = append(late, child)
+		root.End()
+	}
+	require.Equal(t, []string{"first root", "second root", "third root"}, spanNames(exp.GetSpans()))
+
+	// Remembered decisions count against the limit, the least recently
+	// applied one is forgotten.
+	late[1].End()
+	assert.Equal(t, "second", spanNames(exp.GetSpans())[3], "remembered decision not applied")
+	late[0].End()
+	assert.Len(t, exp.GetSpans(), 4, "forgotten decision applied")
+}
+
+func TestTailSamplingSpanProcessorBatched(t *testing.T) {
+	exp := &blockingExporter{release: make(chan struct{})}
+	bsp := sdktrace.NewBatchSpanProcessor(exp)
+	tr, tsp := tailSamplingTracerWith(t, bsp, keepAll, sdktrace.WithDecisionWait(time.Hour))
+
+	ended := make(chan struct{})
+	go func() {
+		defer close(ended)
+		ctx, root := tr.Start(context.Background(), "root")
+		_, child := tr.Start(ctx, "child")
+		child.End()
+		root.End()
+	}()
+	select {
+	case <-ended:
+	case <-time.After(5 * time.Second):
+		t.Fatal("ending the root span blocked on the exporter")
+	}
+
+	close(exp.release)
+	require.NoError(t, tsp.ForceFlush(context.Background()))
+	assert.Equal(t, 2, exp.len())
+	require.NoError(t, tsp.Shutdown(context.Background()))
+	assert.Equal(t, 1, exp.shutdownCount)
+}
+
+func TestTailSamplingSpanProcessorForceFlush(t *testing.T) {
+	exp := tracetest.NewInMemoryExporter()
+	tr, tsp := tailSamplingTracer(t, exp, keepAll, sdktrace.WithDecisionWait(time.Hour))
+
+	ctx, _ := tr.Start(context.Background(), "root")
+	_, child := tr.Start(ctx, "child")
+	child.End()
+	require.Empty(t, exp.GetSpans())
+
+	require.NoError(t, tsp.ForceFlush(context.Background()))
+	assert.Equal(t, []string{"child"}, spanNames(exp.GetSpans()))
+}
+
+func TestTailSamplingSpanProcessorForceFlushCanceled(t *testing.T) {
+	exp := tracetest.NewInMemoryExporter()
+	tr, tsp := tailSamplingTracer(t, exp, keepAll, sdktrace.WithDecisionWait(time.Hour))
+
+	ctx, _ := tr.Start(context.Background(), "root")
+	_, child := tr.Start(ctx, "child")
+	child.End()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.ErrorIs(t, tsp.ForceFlush(ctx), context.Canceled)
+	assert.Empty(t, exp.GetSpans())
+
+	// The spans are still buffered and exported by a later flush.
+	require.NoError(t, tsp.ForceFlush(context.Background()))
+	assert.Equal(t, []string{"child"}, spanNames(exp.GetSpans()))
+}
+
+func TestTailSamplingSpanProcessorShutdown(t *testing.T) {
+	exp := &testBatchExporter{}
+	tr, tsp := tailSamplingTracer(t, exp, keepAll, sdktrace.WithDecisionWait(time.Hour))
+
+	ctx, root := tr.Start(context.Background(), "root")
+	_, child := tr.Start(ctx, "child")
+	child.End()
+
+	require.NoError(t, tsp.Shutdown(context.Background()))
+	assert.Equal(t, 1, exp.len(), "pending trace not exported on shutdown")
+	assert.Equal(t, 1, exp.shutdownCount)
+
+	// Spans ending after shutdown are dropped.
+	root.End()
+	assert.Equal(t, 1, exp.len())
+
+	// Shutdown is idempotent.
+	require.NoError(t, tsp.Shutdown(context.Background()))
+	assert.Equal(t, 1, exp.shutdownCount)
+}
+
+func TestTailSamplingSpanProcessorShutdownCanceled(t *testing.T) {
+	next := &testSpanProcessor{}
+	tr, tsp := tailSamplingTracerWith(t, next, keepAll, sdktrace.WithDecisionWait(time.Hour))
+
+	ctx, _ := tr.Start(context.Background(), "root")
+	_, child := tr.Start(ctx, "child")
+	child.End()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.ErrorIs(t, tsp.Shutdown(ctx), context.Canceled)
+	assert.Empty(t, next.spansEnded, "pending trace not dropped")
+	assert.Equal(t, 1, next.shutdownCount, "span processor not shut down")
+}
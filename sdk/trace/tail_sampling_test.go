@@ -0,0 +1,121 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trace_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func policySpans(stubs ...tracetest.SpanStub) []sdktrace.ReadOnlySpan {
+	return tracetest.SpanStubs(stubs).Snapshots()
+}
+
+func TestErrorPolicy(t *testing.T) {
+	p := sdktrace.ErrorPolicy()
+	assert.Equal(t, "ErrorPolicy", p.Description())
+
+	ok := tracetest.SpanStub{Status: sdktrace.Status{Code: codes.Ok}}
+	failed := tracetest.SpanStub{Status: sdktrace.Status{Code: codes.Error}}
+	assert.False(t, p.ShouldKeep(nil))
+	assert.False(t, p.ShouldKeep(policySpans(ok, tracetest.SpanStub{})))
+	assert.True(t, p.ShouldKeep(policySpans(ok, failed)))
+}
+
+func TestLatencyPolicy(t *testing.T) {
+	p := sdktrace.LatencyPolicy(time.Second)
+	assert.Equal(t, "LatencyPolicy{1s}", p.Description())
+
+	start := time.Now()
+	fast := tracetest.SpanStub{StartTime: start, EndTime: start.Add(time.Millisecond)}
+	slow := tracetest.SpanStub{StartTime: start, EndTime: start.Add(2 * time.Second)}
+	// Together these spans cover more than the threshold.
+	early := tracetest.SpanStub{StartTime: start, EndTime: start.Add(600 * time.Millisecond)}
+	late := tracetest.SpanStub{StartTime: start.Add(500 * time.Millisecond), EndTime: start.Add(1100 * time.Millisecond)}
+
+	assert.False(t, p.ShouldKeep(nil))
+	assert.False(t, p.ShouldKeep(policySpans(fast)))
+	assert.True(t, p.ShouldKeep(policySpans(fast, slow)))
+	assert.True(t, p.ShouldKeep(policySpans(late, early)))
+}
+
+func TestAttributePolicy(t *testing.T) {
+	alice := tracetest.SpanStub{Attributes: []attribute.KeyValue{attribute.String("user", "alice")}}
+	bob := tracetest.SpanStub{Attributes: []attribute.KeyValue{attribute.String("user", "bob")}}
+	anon := tracetest.SpanStub{}
+
+	p := sdktrace.AttributePolicy("user", attribute.StringValue("alice"), attribute.StringValue("carol"))
+	assert.Equal(t, "AttributePolicy{user:[alice,carol]}", p.Description())
+	assert.False(t, p.ShouldKeep(policySpans(anon, bob)))
+	assert.True(t, p.ShouldKeep(policySpans(anon, alice)))
+
+	p = sdktrace.AttributePolicy("user")
+	assert.False(t, p.ShouldKeep(policySpans(anon)))
+	assert.True(t, p.ShouldKeep(policySpans(anon, bob)))
+}
+
+func TestProbabilisticPolicy(t *testing.T) {
+	low := tracetest.SpanStub{SpanContext: trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: trace.TraceID{0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01},
+	})}
+	high := tracetest.SpanStub{SpanContext: trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: trace.TraceID{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	})}
+
+	p := sdktrace.ProbabilisticPolicy(0.5)
+	assert.Equal(t, "ProbabilisticPolicy{0.5}", p.Description())
+	assert.False(t, p.ShouldKeep(nil))
+	assert.True(t, p.ShouldKeep(policySpans(low)))
+	assert.False(t, p.ShouldKeep(policySpans(high)))
+
+	p = sdktrace.ProbabilisticPolicy(2)
+	assert.True(t, p.ShouldKeep(policySpans(low)))
+	assert.True(t, p.ShouldKeep(policySpans(high)))
+
+	p = sdktrace.ProbabilisticPolicy(-1)
+	assert.False(t, p.ShouldKeep(policySpans(low)))
+	assert.False(t, p.ShouldKeep(policySpans(high)))
+}
+
+func TestCompositePolicies(t *testing.T) {
+	failed := tracetest.SpanStub{
+		Status:     sdktrace.Status{Code: codes.Error},
+		Attributes: []attribute.KeyValue{attribute.Bool("retry", true)},
+	}
+	ok := tracetest.SpanStub{Attributes: []attribute.KeyValue{attribute.Bool("retry", true)}}
+
+	errs := sdktrace.ErrorPolicy()
+	retried := sdktrace.AttributePolicy("retry")
+
+	and := sdktrace.AndPolicy(errs, retried)
+	assert.Equal(t, "AndPolicy{ErrorPolicy,AttributePolicy{retry:[]}}", and.Description())
+	assert.True(t, and.ShouldKeep(policySpans(failed)))
+	assert.False(t, and.ShouldKeep(policySpans(ok)))
+	assert.True(t, sdktrace.AndPolicy().ShouldKeep(policySpans(ok)))
+
+	or := sdktrace.OrPolicy(errs, retried)
+	assert.Equal(t, "OrPolicy{ErrorPolicy,AttributePolicy{retry:[]}}", or.Description())
+	assert.True(t, or.ShouldKeep(policySpans(ok)))
+	assert.False(t, or.ShouldKeep(policySpans(tracetest.SpanStub{})))
+	assert.False(t, sdktrace.OrPolicy().ShouldKeep(policySpans(ok)))
+}